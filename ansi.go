@@ -0,0 +1,51 @@
+package log
+
+import (
+	"io"
+	"strings"
+)
+
+// StripANSI удаляет из строки управляющие ANSI последовательности.
+// Полезно для сравнения цветного вывода с ожидаемым текстом.
+func StripANSI(s string) string {
+	if strings.IndexByte(s, 0x1b) < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			i += ansiLen(s[i:])
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+
+	return b.String()
+}
+
+// StripColor возвращает обёртку над w, удаляющую из записываемых данных
+// управляющие ANSI последовательности.
+//
+// Нужна, когда поток уже содержит раскраску, а в цель вывода должен
+// попасть чистый текст, например при сравнении с эталоном в тестах.
+// Каждый вызов Write обрабатывается отдельно, поэтому последовательность
+// не должна разрываться между вызовами, что всегда выполняется для
+// сообщений логгера. Возвращает длину исходных данных при успешной записи.
+func StripColor(w io.Writer) io.Writer {
+	return &stripWriter{w: w}
+}
+
+// Обёртка, удаляющая ANSI последовательности.
+type stripWriter struct {
+	w io.Writer
+}
+
+func (s *stripWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(s.w, StripANSI(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStripANSI(t *testing.T) {
+	for in, want := range map[string]string{
+		"":                               "",
+		"простой текст":                  "простой текст",
+		"\x1b[1;31m[ERROR] \x1b[0mтекст": "[ERROR] текст",
+		"a\x1b[90mb\x1b[0mc":             "abc",
+	} {
+		if got := StripANSI(in); got != want {
+			t.Errorf("StripANSI(%q) = %q, ожидалось %q", in, got, want)
+		}
+	}
+}
+
+func TestStripColor(t *testing.T) {
+	var colored, plain bytes.Buffer
+	l := New(&colored, TRACE)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.SetOutput(StripColor(&plain))
+	l.Warn("внимание")
+
+	if colored.Len() != 0 || strings.Contains(plain.String(), "\x1b") || !strings.HasPrefix(plain.String(), "[WARN]") {
+		t.Errorf("неверный вывод: %q", plain.String())
+	}
+
+	var buf bytes.Buffer
+	NewTestLogger(&buf).Warn("внимание")
+	if strings.Contains(buf.String(), "\x1b") {
+		t.Errorf("тестовый логгер выводит цвет: %q", buf.String())
+	}
+}
@@ -0,0 +1,193 @@
+package log
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Archive пишет журнал в сжатые gzip файлы, разбивая его на отдельные
+// объекты по размеру и времени.
+//
+// Предназначен для сбора NDJSON журналов для последующей загрузки в
+// объектное хранилище. Каждый объект - это самостоятельный gzip поток,
+// который закрывается при ротации, поэтому любой завершённый файл может
+// быть распакован независимо от остальных. Используйте вместе с форматом
+// FormatJSON:
+//
+//	a, err := log.NewArchive("/var/log/app", "app", 64<<20, time.Hour, time.Second)
+//	l := log.New(a, log.INFO)
+//	l.SetFormat(log.FormatJSON)
+//
+// Файлы именуются по времени создания: name-20060102T150405.000000000.ndjson.gz.
+// Безопасен для одновременного использования из нескольких горутин.
+type Archive struct {
+	mu       sync.Mutex
+	dir      string        // Каталог для файлов.
+	name     string        // Префикс имён файлов.
+	maxBytes int64         // Лимит несжатых данных в одном объекте.
+	maxAge   time.Duration // Лимит времени жизни одного объекта.
+	file     *os.File      // Текущий файл.
+	gz       *gzip.Writer  // Текущий gzip поток.
+	size     int64         // Объём несжатых данных в текущем объекте.
+	created  time.Time     // Время создания текущего объекта.
+	done     chan struct{} // Сигнал остановки фоновой горутины.
+	closed   bool          // Архив закрыт.
+}
+
+// NewArchive создаёт новый архив в каталоге dir.
+//
+// Параметры:
+//
+// - name - Префикс имён создаваемых файлов.
+//
+// - maxBytes - Максимальный объём несжатых данных в одном объекте.
+// При его превышении объект закрывается и создаётся новый. 0 - без лимита.
+//
+// - maxAge - Максимальное время жизни одного объекта. По его истечении
+// объект закрывается, а следующая запись создаёт новый. 0 - без лимита.
+//
+// - flush - Интервал периодического сброса gzip потока на диск. Сброшенные
+// данные можно восстановить даже при аварийном завершении. 0 - сброс
+// только при ротации и закрытии.
+//
+// Объект создаётся при первой записи. Не забудьте вызвать Archive.Close()
+// при завершении работы, чтобы закрыть последний объект.
+func NewArchive(dir, name string, maxBytes int64, maxAge, flush time.Duration) (*Archive, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	a := &Archive{
+		dir:      dir,
+		name:     name,
+		maxBytes: maxBytes,
+		maxAge:   maxAge,
+		done:     make(chan struct{}),
+	}
+
+	if flush > 0 {
+		go a.run(flush)
+	}
+
+	return a, nil
+}
+
+// Write записывает данные в текущий объект архива.
+// Ротация выполняется только между вызовами, строка никогда не разрывается.
+func (a *Archive) Write(p []byte) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return 0, os.ErrClosed
+	}
+
+	if a.gz != nil && a.size > 0 {
+		if (a.maxBytes > 0 && a.size+int64(len(p)) > a.maxBytes) ||
+			(a.maxAge > 0 && time.Since(a.created) >= a.maxAge) {
+			if err := a.rotate(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if a.gz == nil {
+		if err := a.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := a.gz.Write(p)
+	a.size += int64(n)
+
+	return n, err
+}
+
+// Flush сбрасывает буфер gzip потока в текущий файл.
+func (a *Archive) Flush() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.gz == nil {
+		return nil
+	}
+
+	return a.gz.Flush()
+}
+
+// Close закрывает текущий объект и останавливает периодический сброс.
+// Повторный вызов ничего не делает.
+func (a *Archive) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return nil
+	}
+
+	a.closed = true
+	close(a.done)
+
+	return a.rotate()
+}
+
+// Открыть новый объект.
+func (a *Archive) open() error {
+	a.created = time.Now()
+
+	path := filepath.Join(a.dir, a.name+"-"+a.created.UTC().Format("20060102T150405.000000000")+".ndjson.gz")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	a.file = f
+	a.gz = gzip.NewWriter(f)
+	a.size = 0
+
+	return nil
+}
+
+// Закрыть текущий объект.
+// Gzip поток завершается полностью, чтобы файл был самостоятельным.
+func (a *Archive) rotate() error {
+	if a.gz == nil {
+		return nil
+	}
+
+	err := a.gz.Close()
+	if e := a.file.Close(); err == nil {
+		err = e
+	}
+
+	a.gz = nil
+	a.file = nil
+
+	return err
+}
+
+// Периодический сброс и ротация по времени.
+func (a *Archive) run(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-a.done:
+			return
+		case <-t.C:
+			a.mu.Lock()
+			if a.gz != nil {
+				if a.maxAge > 0 && time.Since(a.created) >= a.maxAge {
+					a.rotate()
+				} else {
+					a.gz.Flush()
+				}
+			}
+			a.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package log
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewArchive(dir, "app", 200, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := New(a, TRACE)
+	l.SetFormat(FormatJSON)
+	for i := 0; i < 10; i++ {
+		l.Info("сообщение номер ", i)
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Write([]byte("{}\n")); err == nil {
+		t.Error("запись в закрытый архив должна вернуть ошибку")
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "app-*.ndjson.gz"))
+	if len(files) < 2 {
+		t.Fatalf("ожидалась ротация, создано файлов: %d", len(files))
+	}
+
+	var total int
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		sc := bufio.NewScanner(zr)
+		for sc.Scan() {
+			var v map[string]string
+			if err := json.Unmarshal(sc.Bytes(), &v); err != nil {
+				t.Fatalf("%s: некорректная строка %q: %v", name, sc.Text(), err)
+			}
+			if v["level"] != "INFO" {
+				t.Errorf("неверный уровень: %q", v["level"])
+			}
+			total++
+		}
+		if err := sc.Err(); err != nil {
+			t.Fatalf("%s: объект не распаковывается самостоятельно: %v", name, err)
+		}
+		f.Close()
+	}
+	if total != 10 {
+		t.Errorf("прочитано %d строк, ожидалось 10", total)
+	}
+}
+
+func TestArchiveMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewArchive(dir, "app", 0, 20*time.Millisecond, 5*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	a.Write([]byte("{}\n"))
+	time.Sleep(60 * time.Millisecond)
+	a.Write([]byte("{}\n"))
+
+	files, _ := filepath.Glob(filepath.Join(dir, "app-*.ndjson.gz"))
+	if len(files) != 2 {
+		t.Errorf("ожидалось 2 объекта, создано: %d", len(files))
+	}
+}
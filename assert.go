@@ -0,0 +1,58 @@
+package log
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+)
+
+// AssertEnabled включает проверки Logger.Assert().
+//
+// Если false, вызовы Assert ничего не делают и не вычисляют текст
+// сообщения. Отключите проверки в выпускной сборке, например в файле с
+// тегом сборки вашего приложения:
+//
+//	//go:build release
+//
+//	func init() { log.AssertEnabled = false }
+//
+// Значение следует задавать при запуске приложения до первой записи в
+// журнал, одновременное изменение из нескольких горутин не допускается.
+//
+// По умолчанию: true.
+var AssertEnabled = true
+
+// Assert проверяет условие cond и, если оно ложно, записывает сообщение
+// уровня ERROR с местом вызова в исходном коде:
+//
+//	assertion failed: пустая очередь (worker.go:42)
+//
+// Работа приложения не завершается. Вызов игнорируется, если условие
+// истинно, проверки отключены с помощью AssertEnabled или уровень важности
+// логируемых сообщений не соответствует: ERROR.
+//
+// Assert предназначен для поиска нарушений инвариантов во время разработки
+// и не заменяет обработку ошибок: в выпускной сборке проверки могут быть
+// отключены, а код после Assert продолжит выполняться.
+func (l *Logger) Assert(cond bool, v ...interface{}) {
+	if cond || !AssertEnabled || !l.EnabledFast(ERROR) {
+		return
+	}
+
+	var where string
+	if f := callers(1); len(f) > 0 {
+		where = " (" + filepath.Base(f[0].File) + ":" + strconv.Itoa(f[0].Line) + ")"
+	}
+
+	if len(v) == 0 {
+		l.write(ERROR, "assertion failed", where)
+	} else {
+		l.write(ERROR, "assertion failed: ", fmt.Sprint(v...), where)
+	}
+}
+
+// Assert проверяет условие и записывает ошибку в дефолтный логгер, если
+// оно ложно. Подробнее смотрите: Logger.Assert().
+func Assert(cond bool, v ...interface{}) {
+	std.Assert(cond, v...)
+}
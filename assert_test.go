@@ -0,0 +1,36 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAssert(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.Assert(true, "не пишется")
+	l.Assert(false, "пустая очередь")
+	l.Assert(false)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("неверный вывод: %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "assertion failed: пустая очередь (assert_test.go:") {
+		t.Errorf("нет места вызова: %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "assertion failed (assert_test.go:") {
+		t.Errorf("нет места вызова: %q", lines[1])
+	}
+
+	buf.Reset()
+	AssertEnabled = false
+	defer func() { AssertEnabled = true }()
+	l.Assert(false, "отключено")
+	if buf.Len() != 0 {
+		t.Errorf("проверка должна быть отключена: %q", buf.String())
+	}
+}
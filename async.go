@@ -0,0 +1,240 @@
+package log
+
+import (
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// AsyncPolicy описывает поведение AsyncWriter при заполненной очереди.
+//
+// Возможные значения:
+//
+// - AsyncBlock - Ожидать освобождения места в очереди.
+//
+// - AsyncDropNew - Отбросить новое сообщение.
+//
+// - AsyncDropOldest - Отбросить самое старое сообщение в очереди.
+type AsyncPolicy int32
+
+// Поведение AsyncWriter при заполненной очереди.
+const (
+
+	// AsyncBlock - Запись ожидает, пока в очереди освободится место.
+	// Сообщения не теряются, но медленная цель вывода замедляет приложение.
+	// Используется по умолчанию.
+	AsyncBlock AsyncPolicy = iota
+
+	// AsyncDropNew - Новое сообщение отбрасывается.
+	AsyncDropNew
+
+	// AsyncDropOldest - Отбрасывается самое старое сообщение в очереди,
+	// а новое добавляется в её конец.
+	AsyncDropOldest
+)
+
+// AsyncWriter пишет данные в цель вывода в фоновой горутине.
+//
+// Запись ставит копию данных в очередь и сразу возвращает управление,
+// поэтому задержки ввода-вывода не влияют на вызывающий код. Поведение при
+// заполненной очереди задаётся с помощью AsyncWriter.SetPolicy(), а
+// количество отброшенных сообщений возвращает AsyncWriter.Dropped().
+//
+// Перед завершением приложения вызовите Close(), чтобы дописать очередь.
+// Создаётся с помощью конструктора: log.NewAsyncWriter(). Для создания
+// асинхронного логгера используйте: log.NewAsync().
+type AsyncWriter struct {
+	out   io.Writer         // Цель вывода.
+	queue chan asyncMessage // Очередь записи.
+	done  chan struct{}
+
+	send   sync.RWMutex // Защищает очередь от закрытия во время записи.
+	closed bool         // Запись закрыта.
+
+	mu      sync.Mutex
+	idle    sync.Cond   // Сигнал опустошения очереди.
+	pending int         // Сообщений в очереди и в процессе записи.
+	policy  AsyncPolicy // Поведение при заполненной очереди.
+	err     error       // Первая ошибка записи с последнего сброса.
+
+	dropped atomic.Uint64
+}
+
+// Сообщение в очереди AsyncWriter.
+type asyncMessage struct {
+	p       []byte // Копия данных.
+	level   Level  // Уровень важности, если leveled.
+	leveled bool   // Данные получены через WriteLevel.
+}
+
+// NewAsyncWriter создаёт асинхронный писатель в w с очередью на size
+// сообщений и запускает фоновую горутину записи.
+func NewAsyncWriter(w io.Writer, size int) *AsyncWriter {
+	a := &AsyncWriter{
+		out:   w,
+		queue: make(chan asyncMessage, max(size, 1)),
+		done:  make(chan struct{}),
+	}
+	a.idle.L = &a.mu
+
+	go a.run()
+
+	return a
+}
+
+// NewAsync создаёт логгер, пишущий сообщения в out в фоновой горутине.
+//
+// Сообщение форматируется в вызывающей горутине, а запись в out
+// выполняется асинхронно через очередь на bufferSize сообщений, см.:
+// AsyncWriter. Поведение при заполненной очереди настраивается так:
+//
+//	l := log.NewAsync(os.Stderr, log.INFO, 1024)
+//	l.Output().(*log.AsyncWriter).SetPolicy(log.AsyncDropOldest)
+//	defer l.Close()
+//
+// Для ожидания записи всех сообщений используйте Logger.Flush(), а при
+// завершении работы обязательно вызовите Logger.Close().
+func NewAsync(out io.Writer, level Level, bufferSize int) *Logger {
+	return New(NewAsyncWriter(out, bufferSize), level)
+}
+
+// SetPolicy устанавливает поведение при заполненной очереди.
+// Доступные значения AsyncPolicy смотрите в константах пакета.
+func (a *AsyncWriter) SetPolicy(p AsyncPolicy) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.policy = p
+}
+
+// Dropped возвращает количество сообщений, отброшенных из-за заполненной
+// очереди.
+func (a *AsyncWriter) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Write ставит копию данных в очередь записи.
+// Всегда возвращает len(p) и nil, кроме записи в закрытый писатель.
+func (a *AsyncWriter) Write(p []byte) (int, error) {
+	return a.enqueue(asyncMessage{p: p})
+}
+
+// WriteLevel ставит копию данных в очередь записи с сохранением уровня
+// важности. Если цель вывода реализует LevelWriter, при записи уровень
+// передаётся ей.
+func (a *AsyncWriter) WriteLevel(level Level, p []byte) (int, error) {
+	return a.enqueue(asyncMessage{p: p, level: level, leveled: true})
+}
+
+// Поставить копию сообщения в очередь записи.
+func (a *AsyncWriter) enqueue(m asyncMessage) (int, error) {
+	var n = len(m.p)
+
+	a.send.RLock()
+	defer a.send.RUnlock()
+
+	if a.closed {
+		return 0, os.ErrClosed
+	}
+
+	a.mu.Lock()
+	var policy = a.policy
+	a.pending++
+	a.mu.Unlock()
+
+	var b = m
+	b.p = append([]byte(nil), m.p...)
+	switch policy {
+	case AsyncDropNew:
+		select {
+		case a.queue <- b:
+		default:
+			a.dropped.Add(1)
+			a.release(nil)
+		}
+	case AsyncDropOldest:
+		for sent := false; !sent; {
+			select {
+			case a.queue <- b:
+				sent = true
+			default:
+				select {
+				case <-a.queue:
+					a.dropped.Add(1)
+					a.release(nil)
+				default:
+				}
+			}
+		}
+	default:
+		a.queue <- b
+	}
+
+	return n, nil
+}
+
+// Flush дожидается записи всех сообщений из очереди и сбрасывает буфер
+// цели вывода, если она это поддерживает. Возвращает первую ошибку записи,
+// возникшую с последнего вызова Flush.
+func (a *AsyncWriter) Flush() error {
+	a.mu.Lock()
+	for a.pending > 0 {
+		a.idle.Wait()
+	}
+	var err = a.err
+	a.err = nil
+	a.mu.Unlock()
+
+	if f, ok := a.out.(flusher); ok {
+		if e := f.Flush(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Close дописывает очередь и останавливает фоновую горутину.
+// Цель вывода не закрывается. Повторный вызов ничего не делает.
+func (a *AsyncWriter) Close() error {
+	a.send.Lock()
+	if a.closed {
+		a.send.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.queue)
+	a.send.Unlock()
+
+	<-a.done
+	return a.Flush()
+}
+
+// Фоновая запись сообщений из очереди.
+func (a *AsyncWriter) run() {
+	defer close(a.done)
+
+	for m := range a.queue {
+		var err error
+		if m.leveled {
+			_, err = writeLevel(a.out, m.level, m.p)
+		} else {
+			_, err = a.out.Write(m.p)
+		}
+		a.release(err)
+	}
+}
+
+// Отметить сообщение обработанным.
+func (a *AsyncWriter) release(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.err == nil {
+		a.err = err
+	}
+	a.pending--
+	if a.pending == 0 {
+		a.idle.Broadcast()
+	}
+}
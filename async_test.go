@@ -0,0 +1,80 @@
+package log
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Цель вывода, запись в которую ожидает разрешения.
+type gateWriter struct {
+	mu   sync.Mutex
+	buf  bytes.Buffer
+	gate chan struct{}
+}
+
+func (w *gateWriter) Write(p []byte) (int, error) {
+	<-w.gate
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *gateWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.String()
+}
+
+func TestNewAsync(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewAsync(&buf, TRACE, 16)
+	l.Head = false
+
+	for i := 0; i < 100; i++ {
+		l.Info(i)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 100 {
+		t.Errorf("записано %d сообщений, ожидалось 100", n)
+	}
+
+	l.Close()
+	if err := l.write(INFO, "после закрытия"); err == nil {
+		t.Errorf("ожидалась ошибка записи после закрытия")
+	}
+}
+
+func TestAsyncPolicy(t *testing.T) {
+	for _, c := range []struct {
+		policy AsyncPolicy
+		want   string
+	}{
+		{AsyncDropNew, "0\n1\n2\n"},
+		{AsyncDropOldest, "0\n4\n5\n"},
+	} {
+		w := &gateWriter{gate: make(chan struct{})}
+		a := NewAsyncWriter(w, 2)
+		a.SetPolicy(c.policy)
+
+		// Первое сообщение забирается горутиной и ожидает разрешения:
+		a.Write([]byte("0\n"))
+		for len(a.queue) != 0 {
+			runtime.Gosched()
+		}
+		for i := 1; i <= 5; i++ {
+			a.Write([]byte{byte('0' + i), '\n'})
+		}
+
+		close(w.gate)
+		a.Close()
+
+		if w.String() != c.want || a.Dropped() != 3 {
+			t.Errorf("политика %d: записано %q, отброшено %d", c.policy, w.String(), a.Dropped())
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package log
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// SetBatch включает пакетную запись в основную цель вывода.
+//
+// Отформатированные строки накапливаются в памяти и записываются одним
+// вызовом Write, когда накоплено maxLines строк или с момента появления
+// первой строки пакета прошло maxDelay. Это заметно сокращает число
+// системных вызовов при интенсивной записи в файл. Порядок строк
+// сохраняется. Flush() и Close() записывают накопленный пакет сразу. При
+// аварийном завершении строки последнего пакета теряются, поэтому
+// задержку стоит выбирать небольшой, например: 100 мс.
+//
+// Пакетная запись применяется только к основной цели вывода, в том числе
+// заданной позже через SetOutput(). Если основная цель реализует
+// LevelWriter, сообщения пишутся в неё сразу, чтобы сохранить их уровень.
+// Дополнительные цели (AddOutput) и цели уровней (SetLevelOutput)
+// получают сообщения сразу. Ошибка фоновой записи по таймеру
+// возвращается при следующей записи или сбросе.
+//
+// Производные логгеры (With, Clone) пишут в тот же пакет, что и исходный.
+// Значение maxLines <= 0 отключает пакетную запись, maxDelay <= 0 -
+// запись по таймеру.
+//
+// По умолчанию: пакетная запись отключена.
+func (l *Logger) SetBatch(maxLines int, maxDelay time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.batchLines = max(maxLines, 0)
+	l.batchDelay = max(maxDelay, 0)
+	l.setOut(l.mainOutput())
+}
+
+// SetBatch включает пакетную запись дефолтного логгера.
+// Подробнее смотрите: Logger.SetBatch().
+func SetBatch(maxLines int, maxDelay time.Duration) {
+	std.SetBatch(maxLines, maxDelay)
+}
+
+// Установить основную цель вывода с учётом пакетной записи.
+// Накопленный пакет прежней цели записывается. Вызывается под мьютексом.
+func (l *Logger) setOut(w io.Writer) {
+	if b, ok := l.out.(*batchWriter); ok {
+		b.Flush()
+	}
+
+	if l.batchLines > 0 {
+		w = &batchWriter{out: w, maxLines: l.batchLines, maxDelay: l.batchDelay}
+	}
+	l.out = w
+}
+
+// Получить основную цель вывода без обёртки пакетной записи.
+// Вызывается под мьютексом.
+func (l *Logger) mainOutput() io.Writer {
+	if b, ok := l.out.(*batchWriter); ok {
+		return b.out
+	}
+	return l.out
+}
+
+// Пакетная запись в цель вывода, см. Logger.SetBatch().
+type batchWriter struct {
+	mu       sync.Mutex
+	out      io.Writer     // Цель вывода.
+	maxLines int           // Строк в пакете.
+	maxDelay time.Duration // Наибольшая задержка записи.
+	buf      []byte        // Накопленные строки.
+	lines    int           // Количество накопленных строк.
+	timer    *time.Timer   // Таймер записи по задержке.
+	err      error         // Ошибка фоновой записи.
+	closed   bool          // Писатель закрыт, запись идёт напрямую.
+}
+
+// Write добавляет строку в пакет.
+func (w *batchWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return w.out.Write(p)
+	}
+
+	w.buf = append(w.buf, p...)
+	w.lines++
+
+	var err = w.err
+	w.err = nil
+	if w.lines >= w.maxLines {
+		if e := w.flush(); err == nil {
+			err = e
+		}
+	} else if w.timer == nil && w.maxDelay > 0 {
+		w.timer = time.AfterFunc(w.maxDelay, w.expire)
+	}
+
+	return len(p), err
+}
+
+// WriteLevel добавляет строку в пакет. Если цель вывода реализует
+// LevelWriter, пакет записывается, а строка передаётся цели сразу.
+func (w *batchWriter) WriteLevel(level Level, p []byte) (int, error) {
+	lw, ok := w.out.(LevelWriter)
+	if !ok {
+		return w.Write(p)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err = w.flush()
+	n, e := lw.WriteLevel(level, p)
+	if err == nil {
+		err = e
+	}
+
+	return n, err
+}
+
+// Flush записывает накопленный пакет и сбрасывает буфер цели вывода,
+// если она это поддерживает.
+func (w *batchWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err = w.err
+	w.err = nil
+	if e := w.flush(); err == nil {
+		err = e
+	}
+	if f, ok := w.out.(flusher); ok {
+		if e := f.Flush(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Close записывает накопленный пакет и закрывает цель вывода, если она
+// реализует io.Closer. Стандартные потоки os.Stdout и os.Stderr не
+// закрываются.
+func (w *batchWriter) Close() error {
+	var err = w.Flush()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return err
+	}
+	w.closed = true
+
+	if c, ok := w.out.(io.Closer); ok && w.out != io.Writer(os.Stdout) && w.out != io.Writer(os.Stderr) {
+		if e := c.Close(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Записать накопленный пакет. Вызывается под мьютексом.
+func (w *batchWriter) flush() error {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	if w.lines == 0 {
+		return nil
+	}
+
+	_, err := w.out.Write(w.buf)
+	w.buf = w.buf[:0]
+	w.lines = 0
+	if cap(w.buf) > maxPooledBuffer {
+		w.buf = nil
+	}
+
+	return err
+}
+
+// Записать пакет по истечении задержки.
+func (w *batchWriter) expire() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if e := w.flush(); w.err == nil {
+		w.err = e
+	}
+}
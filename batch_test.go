@@ -0,0 +1,79 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Цель вывода, запоминающая каждый вызов Write.
+type countWriter struct {
+	mu     sync.Mutex
+	writes []string
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+func (w *countWriter) list() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.writes...)
+}
+
+func TestSetBatch(t *testing.T) {
+	var w countWriter
+	l := NewTestLogger(&w)
+	l.Head = false
+	l.SetBatch(3, 0)
+
+	l.Info("a")
+	l.With("k", 1).Info("b")
+	if n := len(w.list()); n != 0 {
+		t.Fatalf("пакет записан раньше времени: %d", n)
+	}
+	l.Info("c")
+	l.Info("d")
+	l.Flush()
+	l.Info("e")
+	l.SetOutput(&bytes.Buffer{})
+
+	want := []string{"a\nb k=1\nc\n", "d\n", "e\n"}
+	if got := w.list(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("неверные записи:\n%q\nожидалось:\n%q", got, want)
+	}
+	if l.Output() == io.Writer(&w) {
+		t.Errorf("неверная цель вывода")
+	}
+}
+
+func TestSetBatchDelay(t *testing.T) {
+	var w countWriter
+	l := NewTestLogger(&w)
+	l.Head = false
+	l.SetBatch(100, 20*time.Millisecond)
+
+	l.Info("a")
+	l.Info("b")
+	if l.Output() != io.Writer(&w) {
+		t.Errorf("Output() вернул обёртку пакетной записи")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if got := w.list(); len(got) != 1 || got[0] != "a\nb\n" {
+		t.Errorf("пакет не записан по таймеру: %q", got)
+	}
+
+	l.Info("c")
+	l.SetBatch(0, 0)
+	l.Info("d")
+	if got := w.list(); len(got) != 3 || got[1] != "c\n" || got[2] != "d\n" {
+		t.Errorf("неверные записи после отключения: %q", got)
+	}
+}
@@ -0,0 +1,70 @@
+package log
+
+import (
+	"io"
+	"maps"
+	"reflect"
+)
+
+// Clone создаёт независимую копию логгера.
+//
+// Копируются все экспортируемые настройки (Color, UTC, Head* и т.д.), а
+// также уровень, цели вывода, форматтер, поля сообщений, шаблоны
+// заглушённых сообщений, хуки, обработчик ошибок и имя хоста. Копия
+// получает собственный мьютекс, поэтому изменение её настроек не влияет
+// на исходный логгер. Это безопаснее, чем копирование структуры Logger,
+// при котором копируется мьютекс:
+//
+//	db := l.Clone()
+//	db.SetLevel(log.WARN)
+//	db.Head = false
+//
+// Перехватчики (Recorder, Webhook) не копируются: перехватчики исходного
+// логгера получают и сообщения копии, а перехватчики, подключенные к
+// копии, сообщений исходного логгера не видят. Признак записанного
+// вступления (WritePreamble) тоже не копируется, а остаётся общим, пока
+// копия пишет в ту же цель вывода, поэтому вступление не повторяется.
+// Счётчики и внутреннее состояние записи не копируются. Ограничение
+// частоты SetRateLimit() и подавление повторов SetDedup() копируются, но
+// копия ведёт собственный счёт сообщений. Файлы SetLevelDir остаются во
+// владении исходного логгера.
+func (l *Logger) Clone() *Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := &Logger{
+		out:        l.out,
+		level:      l.level,
+		formatter:  l.formatter,
+		fields:     l.fields,
+		prefix:     l.prefix,
+		hostname:   l.hostname,
+		headerFunc: l.headerFunc,
+		filter:     l.filter,
+		highlights: l.highlights,
+		outs:       append([]io.Writer(nil), l.outs...),
+		levelOut:   l.levelOut,
+		labels:     l.labels,
+		colors:     maps.Clone(l.colors),
+		mutes:      append([]string(nil), l.mutes...),
+		hooks:      append([]Hook(nil), l.hooks...),
+		taps:       &tapSet{parent: l.taps},
+		preamble:   l.preamble,
+		onError:    l.onError,
+		rateN:      l.rateN,
+		ratePer:    l.ratePer,
+		dedupPer:   l.dedupPer,
+		batchLines: l.batchLines,
+		batchDelay: l.batchDelay,
+	}
+	c.enabled.Store(levelMask(l.level))
+
+	src, dst := reflect.ValueOf(l).Elem(), reflect.ValueOf(c).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if src.Type().Field(i).IsExported() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+
+	return c
+}
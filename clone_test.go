@@ -0,0 +1,26 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf).With("app", "api")
+	l.Head = false
+
+	c := l.Clone()
+	c.SetLevel(WARN)
+	c.Name = "db"
+	c.Info("скрыто")
+	c.Warn("клон")
+	l.Info("оригинал")
+
+	if buf.String() != "клон app=api\nоригинал app=api\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+	if l.Level() != TRACE || l.Name != "" || c.Head {
+		t.Errorf("настройки клона связаны с оригиналом")
+	}
+}
@@ -0,0 +1,119 @@
+package log
+
+import acolor "github.com/VolkovRA/GoAColor"
+
+// SetLevelColor заменяет цвет уровня level.
+//
+// Цвет применяется к маркеру уровня в заголовке, а для уровня ERROR или при
+// включённой настройке ColorBody - также к тексту сообщения. Например, если
+// голубой цвет отладочных сообщений плохо читается в теме терминала:
+//
+//	l.SetLevelColor(log.DEBUG, acolor.Bold, acolor.Magenta)
+//
+// Вызов без кодов цвета отключает раскраску уровня. Цвета применяются
+// только при включённой раскраске, см. Logger.Color. Уровни вне диапазона
+// TRACE - ERROR игнорируются.
+func (l *Logger) SetLevelColor(level Level, codes ...acolor.Color) {
+	if level < TRACE || level > ERROR {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.colors == nil {
+		l.colors = make(map[Level]string)
+	}
+	if len(codes) == 0 {
+		l.colors[level] = ""
+	} else {
+		l.colors[level] = acolor.Apply(codes...)
+	}
+}
+
+// SetLevelColor заменяет цвет уровня дефолтного логгера.
+// Подробнее смотрите: Logger.SetLevelColor().
+func SetLevelColor(level Level, codes ...acolor.Color) {
+	std.SetLevelColor(level, codes...)
+}
+
+// ResetLevelColor возвращает уровню level цвет по умолчанию.
+func (l *Logger) ResetLevelColor(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.colors, level)
+}
+
+// Управляющие последовательности цвета, используемые при каждой записи.
+// Вычисляются один раз, чтобы не выделять память на каждое сообщение.
+var (
+	colorClear  = acolor.Clear()               // Сброс цвета.
+	colorHeader = acolor.Apply(acolor.BlackHi) // Заголовок, префикс и стек.
+	colorError  = acolor.Apply(acolor.Red)     // Заголовок ошибок.
+)
+
+// Цвета маркеров уровней по умолчанию.
+var defaultLevelColors = [ERROR + 1]string{
+	TRACE: acolor.Apply(acolor.Bold, acolor.White),
+	DEBUG: acolor.Apply(acolor.Bold, acolor.Cyan),
+	INFO:  acolor.Apply(acolor.Bold, acolor.Green),
+	WARN:  acolor.Apply(acolor.Bold, acolor.Yellow),
+	ERROR: acolor.Apply(acolor.Bold, acolor.Red),
+}
+
+// Цвета текста сообщений по умолчанию.
+var defaultBodyColors = [ERROR + 1]string{
+	TRACE: acolor.Apply(acolor.White),
+	DEBUG: acolor.Apply(acolor.Cyan),
+	INFO:  acolor.Apply(acolor.Green),
+	WARN:  acolor.Apply(acolor.Yellow),
+	ERROR: colorError,
+}
+
+// Получить управляющую последовательность цвета маркера уровня.
+// Пустая строка - без раскраски.
+func (l *Logger) levelColor(level Level) string {
+	if on, ok := l.colors[level]; ok {
+		return on
+	}
+	if level < TRACE || level > ERROR {
+		return defaultLevelColors[ERROR]
+	}
+	return defaultLevelColors[level]
+}
+
+// Получить управляющую последовательность цвета текста сообщения.
+// Без ColorBody раскрашивается только текст ошибок.
+func (l *Logger) bodyColor(level Level) string {
+	if level < TRACE || level > ERROR {
+		level = ERROR
+	}
+	if level != ERROR && !l.ColorBody {
+		return ""
+	}
+	if on, ok := l.colors[level]; ok {
+		return on
+	}
+	return defaultBodyColors[level]
+}
+
+// Раскрасить конец буфера, начиная с позиции start, цветом on.
+// Цвет сбрасывается в конце каждой строки, чтобы не переходить на
+// следующие строки журнала.
+func colorizeTail(buf *[]byte, start int, on string) {
+	var tmp = getBuffer()
+	defer putBuffer(tmp)
+
+	*tmp = append(*tmp, (*buf)[start:]...)
+	*buf = append((*buf)[:start], on...)
+	for _, c := range *tmp {
+		if c == '\n' {
+			*buf = append(*buf, colorClear...)
+			*buf = append(*buf, '\n')
+			*buf = append(*buf, on...)
+			continue
+		}
+		*buf = append(*buf, c)
+	}
+	*buf = append(*buf, colorClear...)
+}
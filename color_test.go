@@ -0,0 +1,63 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	acolor "github.com/VolkovRA/GoAColor"
+)
+
+func TestSetLevelColor(t *testing.T) {
+	l := New(&bytes.Buffer{}, TRACE)
+	l.Color = true
+
+	def := headerLevel(l, DEBUG)
+	if want := acolor.Apply(acolor.Bold, acolor.Cyan) + "[DEBUG] " + acolor.Clear(); def != want {
+		t.Errorf("цвет по умолчанию %q, ожидалось %q", def, want)
+	}
+
+	l.SetLevelColor(DEBUG, acolor.Magenta)
+	if got, want := headerLevel(l, DEBUG), acolor.Apply(acolor.Magenta)+"[DEBUG] "+acolor.Clear(); got != want {
+		t.Errorf("заданный цвет %q, ожидалось %q", got, want)
+	}
+
+	l.SetLevelColor(DEBUG)
+	if got := headerLevel(l, DEBUG); got != "[DEBUG] " {
+		t.Errorf("отключённый цвет %q, ожидалось %q", got, "[DEBUG] ")
+	}
+
+	l.ResetLevelColor(DEBUG)
+	if got := headerLevel(l, DEBUG); got != def {
+		t.Errorf("сброшенный цвет %q, ожидалось %q", got, def)
+	}
+
+	var buf bytes.Buffer
+	l.SetOutput(&buf)
+	l.Color = true
+	l.Head = false
+	l.SetLevelColor(ERROR, acolor.Yellow)
+	l.Error("сбой")
+	if want := acolor.Apply(acolor.Yellow) + "сбой" + acolor.Clear() + "\n"; buf.String() != want {
+		t.Errorf("текст ошибки %q, ожидалось %q", buf.String(), want)
+	}
+}
+
+func TestColorBody(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Color = true
+	l.Head = false
+
+	l.Warn("w")
+	if buf.String() != "w\n" {
+		t.Errorf("текст без ColorBody %q, ожидалось %q", buf.String(), "w\n")
+	}
+
+	buf.Reset()
+	l.ColorBody = true
+	l.Warn("a\nb")
+	on, off := acolor.Apply(acolor.Yellow), acolor.Clear()
+	if want := on + "a" + off + "\n" + on + "b" + off + "\n"; buf.String() != want {
+		t.Errorf("текст с ColorBody %q, ожидалось %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,193 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Config содержит настройки логгера в сериализуемом виде.
+//
+// Поля повторяют экспортируемые настройки Logger, а также уровень
+// важности логируемых сообщений и формат вывода, которые записываются
+// названиями: "info", "json" и т.д. Это позволяет хранить настройки в файле
+// конфигурации JSON. Удобнее всего начинать со снимка текущих настроек,
+// чтобы в файле можно было указать только изменяемые:
+//
+//	c := l.Config()
+//	if err := json.Unmarshal(data, &c); err != nil {
+//		return err
+//	}
+//	c.Apply(l)
+//
+// Location и ExitFunc не сериализуются и задаются в коде. Собственный
+// форматтер (SetFormatter) в Config не представим и снимается как
+// FormatText.
+type Config struct {
+	Level              Level         `json:"level"`
+	Format             Format        `json:"format"`
+	Color              bool          `json:"color"`
+	AutoColor          bool          `json:"auto_color"`
+	ColorBody          bool          `json:"color_body"`
+	LineEnding         string        `json:"line_ending"`
+	UTC                bool          `json:"utc"`
+	Head               bool          `json:"head"`
+	HeadLevel          bool          `json:"head_level"`
+	LevelLowercase     bool          `json:"level_lowercase"`
+	LevelCompact       bool          `json:"level_compact"`
+	LevelWidth         int           `json:"level_width"`
+	PadLevel           bool          `json:"pad_level"`
+	HeadEmoji          bool          `json:"head_emoji"`
+	HeadDate           bool          `json:"head_date"`
+	HeadTime           bool          `json:"head_time"`
+	HeadMC             bool          `json:"head_mc"`
+	TimePrecision      TimePrecision `json:"time_precision"`
+	HeadDualTime       bool          `json:"head_dual_time"`
+	HeadDelta          bool          `json:"head_delta"`
+	TimeLayout         string        `json:"time_layout"`
+	HeadCaller         bool          `json:"head_caller"`
+	HeadGoroutine      bool          `json:"head_goroutine"`
+	HeadSequence       bool          `json:"head_sequence"`
+	HeadHostname       bool          `json:"head_hostname"`
+	HeadPID            bool          `json:"head_pid"`
+	CallerSkip         int           `json:"caller_skip"`
+	Name               string        `json:"name"`
+	WritePreamble      bool          `json:"write_preamble"`
+	StackTrace         bool          `json:"stack_trace"`
+	MaxStackDepth      int           `json:"max_stack_depth"`
+	MaxBodyLen         int           `json:"max_body_len"`
+	MaxFieldLen        int           `json:"max_field_len"`
+	QuoteStrings       bool          `json:"quote_strings"`
+	QuoteBody          bool          `json:"quote_body"`
+	CompactSlices      bool          `json:"compact_slices"`
+	AllowDuplicateKeys bool          `json:"allow_duplicate_keys"`
+	FlushEvery         int           `json:"flush_every"`
+	WrapWidth          int           `json:"wrap_width"`
+	IndentMultiline    bool          `json:"indent_multiline"`
+	MultilinePrefix    string        `json:"multiline_prefix"`
+	ExitCode           int           `json:"exit_code"`
+}
+
+// Config возвращает снимок текущих настроек логгера.
+// Подробнее смотрите: log.Config.
+func (l *Logger) Config() Config {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := Config{Level: l.level}
+	switch l.formatter.(type) {
+	case JSONFormatter:
+		c.Format = FormatJSON
+	case GELFFormatter:
+		c.Format = FormatGELF
+	case LogfmtFormatter:
+		c.Format = FormatLogfmt
+	}
+
+	src, dst := reflect.ValueOf(l).Elem(), reflect.ValueOf(&c).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		if f := src.FieldByName(dst.Type().Field(i).Name); f.IsValid() && f.Type() == dst.Field(i).Type() {
+			dst.Field(i).Set(f)
+		}
+	}
+
+	return c
+}
+
+// Apply устанавливает логгеру l все настройки из c, включая уровень
+// важности и формат вывода. Формат заменяет собственный форматтер,
+// установленный SetFormatter(). Location и ExitFunc не изменяются.
+func (c Config) Apply(l *Logger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.level = c.Level
+	l.enabled.Store(levelMask(c.Level))
+	l.formatter = formatter(c.Format)
+
+	src, dst := reflect.ValueOf(&c).Elem(), reflect.ValueOf(l).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if f := dst.FieldByName(src.Type().Field(i).Name); f.IsValid() && f.Type() == src.Field(i).Type() {
+			f.Set(src.Field(i))
+		}
+	}
+}
+
+// NewFromConfig создаёт новый логгер с целью вывода out и настройками c.
+// Настройка Color берётся из c, даже если включена AutoColor.
+func NewFromConfig(out io.Writer, c Config) *Logger {
+	l := New(out, c.Level)
+	c.Apply(l)
+	return l
+}
+
+// DefaultConfig возвращает настройки нового логгера с уровнем level.
+// Подробнее смотрите: log.New().
+func DefaultConfig(level Level) Config {
+	return New(io.Discard, level).Config()
+}
+
+// DiffConfig сравнивает настройки двух логгеров.
+//
+// Сравниваются все экспортируемые поля, уровень важности логируемых
+// сообщений и формат вывода. Возвращает по одной строке на каждое
+// различие в виде: "поле: a=значение b=значение". Если настройки
+// совпадают, возвращает пустой список.
+//
+// Полезно, когда производный логгер ведёт себя не так, как ожидалось.
+func DiffConfig(a, b *Logger) []string {
+	sa, sb := a.settings(), b.settings()
+
+	var res []string
+	for i := range sa {
+		if sa[i].value != sb[i].value {
+			res = append(res, fmt.Sprintf("%s: a=%s b=%s", sa[i].name, sa[i].value, sb[i].value))
+		}
+	}
+
+	return res
+}
+
+// Одна настройка логгера.
+type setting struct {
+	name  string
+	value string
+}
+
+// Получить снимок настроек логгера.
+// Порядок настроек одинаков для всех логгеров.
+func (l *Logger) settings() []setting {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	res := []setting{
+		{"Level", levelName(l.level)},
+		{"Format", formatName(l.formatter)},
+	}
+
+	v := reflect.ValueOf(l).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.IsExported() {
+			res = append(res, setting{f.Name, fmt.Sprintf("%v", v.Field(i).Interface())})
+		}
+	}
+
+	return res
+}
+
+// Получить название форматтера.
+func formatName(f Formatter) string {
+	switch f.(type) {
+	case nil, TextFormatter:
+		return "text"
+	case JSONFormatter:
+		return "json"
+	case GELFFormatter:
+		return "gelf"
+	case LogfmtFormatter:
+		return "logfmt"
+	default:
+		return fmt.Sprintf("%T", f)
+	}
+}
@@ -0,0 +1,78 @@
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffConfig(t *testing.T) {
+	a := New(io.Discard, INFO)
+	b := New(io.Discard, INFO)
+	if d := DiffConfig(a, b); len(d) != 0 {
+		t.Errorf("одинаковые логгеры различаются: %q", d)
+	}
+
+	a.Color = true
+	b.SetLevel(DEBUG)
+	b.SetFormat(FormatJSON)
+	b.Color = false
+	b.Name = "db"
+
+	want := []string{
+		"Level: a=INFO b=DEBUG",
+		"Format: a=text b=json",
+		"Color: a=true b=false",
+		"Name: a= b=db",
+	}
+	if d := DiffConfig(a, b); !reflect.DeepEqual(d, want) {
+		t.Errorf("неверные различия:\n%q\nожидалось:\n%q", d, want)
+	}
+}
+
+func TestConfigJSON(t *testing.T) {
+	l := New(io.Discard, DEBUG)
+	l.SetFormat(FormatLogfmt)
+	l.HeadCaller = true
+	l.Name = "db"
+
+	data, err := json.Marshal(l.Config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(data); !strings.Contains(s, `"level":"DEBUG","format":"logfmt"`) || !strings.Contains(s, `"head_caller":true`) {
+		t.Errorf("неверный JSON: %s", s)
+	}
+
+	c := DefaultConfig(INFO)
+	if err := json.Unmarshal([]byte(`{"level":"warn","format":"json","utc":false,"name":"api"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	n := NewFromConfig(io.Discard, c)
+	if n.Level() != WARN || formatName(n.formatter) != "json" || n.UTC || n.Name != "api" || !n.Head {
+		t.Errorf("настройки не применены: %+v", n.Config())
+	}
+	if d := DiffConfig(NewFromConfig(io.Discard, l.Config()), l); len(d) != 0 {
+		t.Errorf("снимок настроек неполон: %q", d)
+	}
+
+	if err := json.Unmarshal([]byte(`{"format":"xml"}`), &c); err == nil {
+		t.Errorf("неизвестный формат принят")
+	}
+}
+
+func TestConfigFields(t *testing.T) {
+	c := reflect.TypeOf(Config{})
+	l := reflect.TypeOf(Logger{})
+	for i := 0; i < l.NumField(); i++ {
+		f := l.Field(i)
+		if !f.IsExported() || f.Name == "Location" || f.Name == "ExitFunc" {
+			continue
+		}
+		if v, ok := c.FieldByName(f.Name); !ok || v.Type != f.Type {
+			t.Errorf("в Config нет настройки %s", f.Name)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"context"
+	"io"
+)
+
+// Ключ логгера в контексте.
+type loggerKey struct{}
+
+// NewContext возвращает копию контекста ctx, содержащую логгер l.
+//
+// Логгер извлекается из контекста с помощью log.FromContext(). Это позволяет
+// передавать логгер через цепочку вызовов и в дочерние горутины вместе с
+// контекстом запроса.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext возвращает логгер, сохранённый в контексте с помощью
+// log.NewContext(). Если контекст не содержит логгера, возвращает
+// дефолтный логгер: log.Default().
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*Logger); ok {
+		return l
+	}
+	return std
+}
+
+// Capture создаёт изолированный перехват журнала для кода, выполняемого
+// с возвращённым контекстом.
+//
+// В контекст помещается производный логгер с настройками и уровнем логгера
+// из ctx, сообщения которого не попадают в общую цель вывода, а только
+// перехватываются возвращённым Recorder. Перехватываются и сообщения
+// логгеров, производных от него: FromContext(ctx).With(...) или Named(...).
+// Перехватчики исходного логгера этих сообщений не получают. Благодаря
+// этому параллельные тесты (t.Parallel) не видят сообщений друг друга,
+// даже если используют дефолтный логгер.
+//
+// Перехват работает только для кода, который получает логгер через
+// log.FromContext() из этого контекста или производного от него. Дочерние
+// горутины должны получать этот контекст явно: Go не связывает значения с
+// горутинами, поэтому вызовы пакетных функций вроде log.Info() и логгеров,
+// полученных иным способом, перехвачены не будут.
+//
+//	ctx, rec := log.Capture(context.Background())
+//	handle(ctx) // Внутри: log.FromContext(ctx).Info("...")
+//	rec.AssertContains(t, log.INFO, "...")
+func Capture(ctx context.Context) (context.Context, *Recorder) {
+	l := FromContext(ctx).Clone()
+	l.out = io.Discard
+	l.outs = nil
+	l.levelOut = [ERROR + 1]io.Writer{}
+	l.taps = &tapSet{}
+
+	return NewContext(ctx, l), NewRecorder(l)
+}
@@ -0,0 +1,64 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	if FromContext(context.Background()) != std {
+		t.Error("без логгера в контексте ожидался дефолтный логгер")
+	}
+
+	l := New(nil, INFO)
+	if FromContext(NewContext(context.Background(), l)) != l {
+		t.Error("логгер не извлечён из контекста")
+	}
+}
+
+func TestCaptureParallel(t *testing.T) {
+	for i := 0; i < 4; i++ {
+		i := i
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			t.Parallel()
+
+			ctx, rec := Capture(context.Background())
+			var wg sync.WaitGroup
+			for j := 0; j < 3; j++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					FromContext(ctx).Info("тест ", i)
+				}()
+			}
+			wg.Wait()
+
+			records := rec.Records()
+			if len(records) != 3 {
+				t.Fatalf("перехвачено %d записей, ожидалось 3", len(records))
+			}
+			for _, r := range records {
+				if r.Message != fmt.Sprint("тест ", i) {
+					t.Errorf("чужое сообщение: %q", r.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestCaptureDerived(t *testing.T) {
+	parent := NewRecorder(std)
+	defer parent.Close()
+
+	ctx, rec := Capture(context.Background())
+	FromContext(ctx).With("id", 1).Info("из With")
+	FromContext(ctx).Named("db").Warn("из Named")
+
+	rec.AssertContains(t, INFO, "из With")
+	rec.AssertContains(t, WARN, "из Named")
+	if n := len(parent.Records()); n != 0 {
+		t.Errorf("перехватчик дефолтного логгера получил %d записей", n)
+	}
+}
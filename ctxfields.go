@@ -0,0 +1,154 @@
+package log
+
+import (
+	"context"
+	"sync"
+)
+
+// Значение контекста, выводимое в поле сообщения.
+type ctxField struct {
+	key  interface{} // Ключ значения в контексте.
+	name string      // Ключ поля в сообщении.
+}
+
+// Реестр значений контекста, выводимых в поля сообщений.
+var ctxFields struct {
+	mu   sync.RWMutex
+	list []ctxField
+}
+
+// RegisterContextField регистрирует значение контекста, которое выводится
+// в поле сообщения с ключом name при записи через Logger.WithContext() и
+// методы *Ctx: InfoCtx(), ErrorCtx() и т.д.
+//
+// Это позволяет связать сообщения одного запроса по идентификатору
+// трассировки, не передавая его вручную в каждое сообщение:
+//
+//	log.RegisterContextField(traceKey{}, "trace")
+//	...
+//	log.InfoCtx(ctx, "запрос обработан") // ... запрос обработан trace=4bf92f
+//
+// Повторная регистрация ключа заменяет имя поля. Регистрация действует для
+// всех логгеров и обычно выполняется при инициализации программы.
+func RegisterContextField(key interface{}, name string) {
+	ctxFields.mu.Lock()
+	defer ctxFields.mu.Unlock()
+
+	for i, v := range ctxFields.list {
+		if v.key == key {
+			ctxFields.list[i].name = name
+			return
+		}
+	}
+	ctxFields.list = append(ctxFields.list, ctxField{key: key, name: name})
+}
+
+// WithContext создаёт сообщение с полями из значений контекста ctx,
+// зарегистрированных с помощью log.RegisterContextField().
+//
+// Поля выводятся в порядке регистрации. Если значения нет в контексте,
+// поле не добавляется. Подробнее о Entry смотрите: Logger.WithField().
+func (l *Logger) WithContext(ctx context.Context) *Entry {
+	ctxFields.mu.RLock()
+	defer ctxFields.mu.RUnlock()
+
+	var e = &Entry{Logger: l}
+	for _, f := range ctxFields.list {
+		if v := ctx.Value(f.key); v != nil {
+			e.Fields = append(e.Fields, Field{Key: f.name, Value: v})
+		}
+	}
+	return e
+}
+
+// WithContext создаёт сообщение с полями из значений контекста для
+// логгера из этого контекста. Если контекст не содержит логгера,
+// используется дефолтный. Подробнее смотрите: Logger.WithContext().
+func WithContext(ctx context.Context) *Entry {
+	return FromContext(ctx).WithContext(ctx)
+}
+
+// ErrorCtx выводит сообщение об ошибке с полями из контекста ctx.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (l *Logger) ErrorCtx(ctx context.Context, v ...interface{}) {
+	if !l.EnabledFast(ERROR) {
+		return
+	}
+
+	l.WithContext(ctx).Error(v...)
+}
+
+// WarnCtx выводит предупреждение с полями из контекста ctx.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (l *Logger) WarnCtx(ctx context.Context, v ...interface{}) {
+	if !l.EnabledFast(WARN) {
+		return
+	}
+
+	l.WithContext(ctx).Warn(v...)
+}
+
+// InfoCtx выводит информационное сообщение с полями из контекста ctx.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (l *Logger) InfoCtx(ctx context.Context, v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.WithContext(ctx).Info(v...)
+}
+
+// DebugCtx выводит отладочное сообщение с полями из контекста ctx.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (l *Logger) DebugCtx(ctx context.Context, v ...interface{}) {
+	if !l.EnabledFast(DEBUG) {
+		return
+	}
+
+	l.WithContext(ctx).Debug(v...)
+}
+
+// TraceCtx выводит произвольное сообщение с полями из контекста ctx.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (l *Logger) TraceCtx(ctx context.Context, v ...interface{}) {
+	if !l.EnabledFast(TRACE) {
+		return
+	}
+
+	l.WithContext(ctx).Trace(v...)
+}
+
+// ErrorCtx выводит сообщение об ошибке с полями из контекста ctx в логгер
+// из этого контекста. Если контекст не содержит логгера, используется
+// дефолтный.
+func ErrorCtx(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).ErrorCtx(ctx, v...)
+}
+
+// WarnCtx выводит предупреждение с полями из контекста ctx в логгер из
+// этого контекста. Если контекст не содержит логгера, используется
+// дефолтный.
+func WarnCtx(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).WarnCtx(ctx, v...)
+}
+
+// InfoCtx выводит информационное сообщение с полями из контекста ctx в
+// логгер из этого контекста. Если контекст не содержит логгера,
+// используется дефолтный.
+func InfoCtx(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).InfoCtx(ctx, v...)
+}
+
+// DebugCtx выводит отладочное сообщение с полями из контекста ctx в логгер
+// из этого контекста. Если контекст не содержит логгера, используется
+// дефолтный.
+func DebugCtx(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).DebugCtx(ctx, v...)
+}
+
+// TraceCtx выводит произвольное сообщение с полями из контекста ctx в
+// логгер из этого контекста. Если контекст не содержит логгера,
+// используется дефолтный.
+func TraceCtx(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).TraceCtx(ctx, v...)
+}
@@ -0,0 +1,28 @@
+package log
+
+import (
+	"context"
+	"testing"
+)
+
+type testTraceKey struct{}
+type testUserKey struct{}
+
+func TestInfoCtx(t *testing.T) {
+	RegisterContextField(testTraceKey{}, "trace")
+	RegisterContextField(testUserKey{}, "user")
+
+	l, sink := NewTest()
+	l.Head = false
+
+	ctx := context.WithValue(context.Background(), testTraceKey{}, "4bf92f")
+	l.InfoCtx(ctx, "без пользователя")
+
+	ctx = context.WithValue(ctx, testUserKey{}, 7)
+	InfoCtx(NewContext(ctx, l), "с пользователем")
+
+	want := "без пользователя trace=4bf92f\nс пользователем trace=4bf92f user=7\n"
+	if sink.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", sink.String(), want)
+	}
+}
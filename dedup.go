@@ -0,0 +1,135 @@
+package log
+
+import (
+	"hash/maphash"
+	"strconv"
+	"time"
+)
+
+// Количество недавних сообщений, отслеживаемых SetDedup().
+const dedupSize = 16
+
+// Зерно хеширования текста сообщений SetDedup().
+var dedupSeed = maphash.MakeSeed()
+
+// Недавнее сообщение, отслеживаемое SetDedup().
+type dedupEntry struct {
+	hash  uint64    // Хеш текста сообщения.
+	level Level     // Уровень сообщения.
+	text  string    // Текст сообщения.
+	start time.Time // Время записи сообщения, начало окна.
+	count int       // Подавлено повторов в окне.
+}
+
+// SetDedup подавляет точные повторы сообщений в течение интервала window.
+//
+// Если сообщение того же уровня с тем же текстом уже было записано менее
+// window назад, повтор не пишется, а только подсчитывается. Когда окно
+// сообщения истекает, в журнал пишется итоговая строка того же уровня,
+// например: "соединение потеряно (repeated 12 times)". Это удобно для
+// «мигающих» предупреждений, которые иначе забивают журнал.
+//
+// Сравнивается только текст сообщения без заголовка и полей, поэтому
+// время записи на сравнение не влияет. Отслеживаются 16 сообщений,
+// встречавшихся последними: при переполнении вытесняется то, которое
+// дольше всех не повторялось. Поэтому чередование нескольких
+// повторяющихся сообщений тоже схлопывается. Итоговые строки пишутся
+// перед следующим записанным сообщением с другим текстом, при записи
+// после истечения окна, а также при вызове Flush() или Close(), но не по
+// таймеру. Подавленные повторы учитываются в Logger.SuppressedCount().
+//
+// Значение window <= 0 отключает подавление.
+//
+// По умолчанию: подавления нет.
+func (l *Logger) SetDedup(window time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.dedupPer = max(window, 0)
+	l.dedupList = nil
+}
+
+// SetDedup подавляет точные повторы сообщений дефолтного логгера.
+// Подробнее смотрите: Logger.SetDedup().
+func SetDedup(window time.Duration) {
+	std.SetDedup(window)
+}
+
+// Проверить, является ли сообщение повтором недавнего.
+//
+// Возвращает false для повтора, который нужно подавить. Повтор становится
+// самым свежим сообщением списка. Также возвращает сообщения, для которых
+// нужно записать итоговую строку: с истёкшим окном, а если сообщение
+// новое - все с подавленными повторами. Вызывается под мьютексом записи.
+func (l *Logger) dedup(level Level, text string, now time.Time) (ok bool, done []dedupEntry) {
+	var hash = maphash.String(dedupSeed, text)
+	var found = -1
+
+	// Истёкшие окна:
+	var list = l.dedupList[:0]
+	for _, d := range l.dedupList {
+		if now.Sub(d.start) >= l.dedupPer {
+			if d.count > 0 {
+				done = append(done, d)
+			}
+			continue
+		}
+		if d.hash == hash && d.level == level && d.text == text {
+			found = len(list)
+		}
+		list = append(list, d)
+	}
+	l.dedupList = list
+
+	// Повтор:
+	if found >= 0 {
+		var d = l.dedupList[found]
+		d.count++
+		copy(l.dedupList[found:], l.dedupList[found+1:])
+		l.dedupList[len(l.dedupList)-1] = d
+		l.suppressed.Add(1)
+		return false, done
+	}
+
+	// Новое сообщение:
+	for i, d := range l.dedupList {
+		if d.count > 0 {
+			done = append(done, d)
+			l.dedupList[i].count = 0
+		}
+	}
+	if len(l.dedupList) >= dedupSize {
+		l.dedupList = append(l.dedupList[:0], l.dedupList[1:]...)
+	}
+	l.dedupList = append(l.dedupList, dedupEntry{hash: hash, level: level, text: text, start: now})
+
+	return true, done
+}
+
+// Записать итоговые строки подавленных повторов.
+// Вызывается с захваченным мьютексом логгера.
+func (l *Logger) writeRepeated(done []dedupEntry) error {
+	var err error
+	for _, d := range done {
+		if e := l.emitText(d.level, d.text+" (repeated "+strconv.Itoa(d.count)+" times)", nil, nil); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Записать итоговые строки всех подавленных повторов, не дожидаясь
+// истечения окон. Вызывается с захваченным мьютексом логгера.
+func (l *Logger) flushDedup() error {
+	l.wmu.Lock()
+	var done []dedupEntry
+	for _, d := range l.dedupList {
+		if d.count > 0 {
+			done = append(done, d)
+		}
+	}
+	l.dedupList = nil
+	l.wmu.Unlock()
+
+	return l.writeRepeated(done)
+}
@@ -0,0 +1,75 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestSetDedup(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetDedup(50 * time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		l.Warn("нет соединения")
+		l.Info("нет соединения")
+		l.Warn("диск заполнен")
+	}
+	time.Sleep(60 * time.Millisecond)
+	l.Info("готово")
+	l.Warn("диск заполнен")
+	l.Warn("диск заполнен")
+	l.Flush()
+
+	want := "нет соединения\nнет соединения\nдиск заполнен\n" +
+		"нет соединения (repeated 2 times)\n" +
+		"нет соединения (repeated 2 times)\n" +
+		"диск заполнен (repeated 2 times)\n" +
+		"готово\nдиск заполнен\n" +
+		"диск заполнен (repeated 1 times)\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if n := l.SuppressedCount(); n != 7 {
+		t.Errorf("подавлено %d сообщений, ожидалось 7", n)
+	}
+}
+
+func TestSetDedupEvict(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetDedup(time.Hour)
+
+	l.Info("a")
+	for i := 0; i < dedupSize; i++ {
+		if i == dedupSize-1 {
+			l.Info("a") // Повтор продлевает жизнь сообщения в списке.
+		}
+		l.Info(i)
+	}
+	l.Info("a")
+	l.Info(0)
+
+	if want := "a\n0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\na (repeated 1 times)\n15\na (repeated 1 times)\n0\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestSetDedupNext(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetDedup(time.Hour)
+
+	l.Warn("A")
+	l.Warn("A")
+	l.Warn("A")
+	l.Warn("B")
+
+	if want := "A\nA (repeated 2 times)\nB\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
@@ -0,0 +1,50 @@
+package log
+
+import (
+	"fmt"
+
+	acolor "github.com/VolkovRA/GoAColor"
+)
+
+// Diff записывает изменение значения в виде двухстрочного сравнения.
+//
+// Сообщение состоит из заголовка label и двух строк: старого значения с
+// префиксом "-" и нового с префиксом "+":
+//
+//	конфигурация.timeout:
+//	-5s
+//	+10s
+//
+// При включённой раскраске и текстовом формате старое значение выводится
+// красным цветом, а новое зелёным. Значения преобразуются в текст так же,
+// как аргументы fmt.Sprint(). Вызов игнорируется, если уровень важности
+// логируемых сообщений не соответствует: level.
+func (l *Logger) Diff(level Level, label string, old, new interface{}) {
+	if !l.EnabledFast(level) {
+		return
+	}
+
+	l.mu.Lock()
+	var color = l.Color
+	switch l.formatter.(type) {
+	case nil, TextFormatter:
+	default:
+		color = false
+	}
+	l.mu.Unlock()
+
+	var o = "-" + fmt.Sprint(old)
+	var n = "+" + fmt.Sprint(new)
+	if color {
+		o = acolor.Apply(acolor.Red) + o + acolor.Clear()
+		n = acolor.Apply(acolor.Green) + n + acolor.Clear()
+	}
+
+	l.write(level, label, ":\n", o, "\n", n)
+}
+
+// Diff записывает изменение значения в дефолтный логгер.
+// Подробнее смотрите: Logger.Diff().
+func Diff(level Level, label string, old, new interface{}) {
+	std.Diff(level, label, old, new)
+}
@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.Diff(INFO, "timeout", 5*time.Second, 10*time.Second)
+	if buf.String() != "timeout:\n-5s\n+10s\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+
+	buf.Reset()
+	l.Color = true
+	l.Diff(INFO, "n", 1, 2)
+	if buf.String() == "n:\n-1\n+2\n" || StripANSI(buf.String()) != "n:\n-1\n+2\n" {
+		t.Errorf("ожидалась раскраска значений: %q", buf.String())
+	}
+
+	buf.Reset()
+	l.SetFormat(FormatJSON)
+	l.Diff(INFO, "n", 1, 2)
+	if StripANSI(buf.String()) != buf.String() {
+		t.Errorf("раскраска не должна применяться в JSON: %q", buf.String())
+	}
+
+	buf.Reset()
+	l.SetLevel(WARN)
+	l.Diff(INFO, "n", 1, 2)
+	if buf.Len() != 0 {
+		t.Errorf("сообщение должно быть отфильтровано уровнем: %q", buf.String())
+	}
+}
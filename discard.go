@@ -0,0 +1,33 @@
+package log
+
+import "io"
+
+// Общий логгер, отбрасывающий все сообщения. См.: Discard().
+var discard = func() *Logger {
+	l := New(io.Discard, ERROR+1)
+	l.Color = false
+	l.AutoColor = false
+	return l
+}()
+
+// Discard возвращает логгер, отбрасывающий все сообщения.
+//
+// Уровень логгера выше ERROR, поэтому методы записи возвращаются сразу
+// после проверки уровня: без форматирования, блокировок и выделения
+// памяти. Подходит для отключённого логирования, бенчмарков и как
+// безопасное значение по умолчанию в библиотеках, принимающих логгер:
+//
+//	func NewClient(l *log.Logger) *Client {
+//		if l == nil {
+//			l = log.Discard()
+//		}
+//		...
+//	}
+//
+// Возвращается один и тот же логгер, поэтому не изменяйте его настройки.
+// Для собственного «тихого» логгера используйте log.New(io.Discard, ...).
+// Fatal и Panic сохраняют своё поведение: завершают приложение и вызывают
+// panic() соответственно.
+func Discard() *Logger {
+	return discard
+}
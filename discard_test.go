@@ -0,0 +1,23 @@
+package log
+
+import "testing"
+
+func TestDiscard(t *testing.T) {
+	l := Discard()
+	if l != Discard() {
+		t.Error("Discard() возвращает разные логгеры")
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		l.Error("сбой")
+		l.Infof("n=%d", 1)
+	})
+	if allocs != 0 {
+		t.Errorf("выделений памяти на вызов: %v", allocs)
+	}
+	for v := TRACE; v <= ERROR; v++ {
+		if l.EnabledFast(v) {
+			t.Errorf("уровень %v включён", v)
+		}
+	}
+}
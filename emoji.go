@@ -0,0 +1,91 @@
+package log
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Ширина значка уровня в колонках терминала.
+const emojiWidth = 2
+
+// Значки уровней, дополненные пробелами до одинаковой ширины.
+var emojis = func() [ERROR + 1]string {
+	var res [ERROR + 1]string
+	for level, v := range [...]string{
+		TRACE: "🔍",
+		DEBUG: "🐛",
+		INFO:  "ℹ️",
+		WARN:  "⚠️",
+		ERROR: "❌",
+	} {
+		res[level] = v + strings.Repeat(" ", emojiWidth-displayWidth(v)) + " "
+	}
+	return res
+}()
+
+// Получить значок уровня логирования.
+func levelEmoji(level Level) string {
+	if level < TRACE || level > ERROR {
+		level = ERROR
+	}
+	return emojis[level]
+}
+
+// Получить ширину строки в колонках терминала.
+//
+// Учитывает широкие символы (эмодзи с представлением по умолчанию,
+// иероглифы) и символы нулевой ширины (селекторы вариантов, соединители,
+// комбинируемые знаки). Управляющие ANSI последовательности не учитываются.
+func displayWidth(s string) int {
+	var w int
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			i += ansiLen(s[i:])
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		w += runeWidth(r)
+	}
+	return w
+}
+
+// Получить длину управляющей ANSI последовательности в начале строки.
+func ansiLen(s string) int {
+	if len(s) < 2 || s[1] != '[' {
+		return 1
+	}
+	for i := 2; i < len(s); i++ {
+		if s[i] >= 0x40 && s[i] <= 0x7e {
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// Получить ширину символа в колонках терминала.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0x200d || (r >= 0xfe00 && r <= 0xfe0f) || (r >= 0x0300 && r <= 0x036f) || r < 0x20:
+		return 0
+	case r >= 0x1100 && r <= 0x115f,
+		r >= 0x2e80 && r <= 0xa4cf,
+		r >= 0xac00 && r <= 0xd7a3,
+		r >= 0xf900 && r <= 0xfaff,
+		r >= 0xfe30 && r <= 0xfe4f,
+		r >= 0xff00 && r <= 0xff60,
+		r >= 0xffe0 && r <= 0xffe6,
+		r >= 0x1f300 && r <= 0x1f64f,
+		r >= 0x1f680 && r <= 0x1f6ff,
+		r >= 0x1f900 && r <= 0x1f9ff,
+		r >= 0x20000 && r <= 0x3fffd,
+		r == 0x231a, r == 0x231b, r == 0x23f0, r == 0x23f3,
+		r == 0x2705, r == 0x270a, r == 0x270b, r == 0x2728,
+		r == 0x274c, r == 0x274e, r == 0x2753, r == 0x2754, r == 0x2755, r == 0x2757,
+		r == 0x26a1, r == 0x26aa, r == 0x26ab, r == 0x26bd, r == 0x26be,
+		r == 0x26c4, r == 0x26c5, r == 0x26d4, r == 0x26ea, r == 0x26f5, r == 0x26fa, r == 0x26fd:
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,49 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	for s, want := range map[string]int{
+		"abc":                      3,
+		"привет":                   6,
+		"🔍":                        2,
+		"ℹ️":                       1,
+		"⚠️":                       1,
+		"❌":                        2,
+		"日本":                       4,
+		"\x1b[1;31mкрасный\x1b[0m": 7,
+	} {
+		if w := displayWidth(s); w != want {
+			t.Errorf("displayWidth(%q) = %d, ожидалось %d", s, w, want)
+		}
+	}
+}
+
+func TestHeadEmoji(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Color = false
+	l.HeadEmoji = true
+	l.HeadDate = false
+
+	l.Trace("a")
+	l.Info("b")
+	l.Warn("c")
+
+	var col = -1
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		i := strings.Index(line, "[")
+		if w := displayWidth(line[:i]); col == -1 {
+			col = w
+		} else if w != col {
+			t.Errorf("маркер уровня не выровнен: %q", line)
+		}
+	}
+	if col != emojiWidth+1 {
+		t.Errorf("неверная ширина значка: %d", col)
+	}
+}
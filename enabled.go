@@ -0,0 +1,32 @@
+package log
+
+// EnabledFast проверяет актуальность указанного уровня логирования.
+//
+// Читает одно атомарное значение: битовую маску активных уровней, которая
+// пересчитывается только при изменении уровня. Поэтому безопасен при
+// одновременном вызове SetLevel() из другой горутины. Этой же проверкой
+// пользуются Logger.IsLevel() и все методы записи сообщений. Предназначен
+// для самых горячих участков кода, где логирование защищено проверкой в
+// каждой итерации цикла.
+//
+// Возвращает true, если указанный уровень логирования актуален.
+func (l *Logger) EnabledFast(level Level) bool {
+	return uint32(level) < 32 && l.enabled.Load()&(1<<uint32(level)) != 0
+}
+
+// EnabledFast проверяет актуальность указанного уровня логирования
+// дефолтного логгера, читая одно атомарное значение.
+func EnabledFast(level Level) bool {
+	return std.EnabledFast(level)
+}
+
+// Получить битовую маску уровней, актуальных при указанном уровне логирования.
+func levelMask(level Level) uint32 {
+	var mask uint32
+	for v := TRACE; v <= ERROR; v++ {
+		if v >= level {
+			mask |= 1 << uint32(v)
+		}
+	}
+	return mask
+}
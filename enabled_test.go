@@ -0,0 +1,46 @@
+package log
+
+import (
+	"io"
+	"testing"
+)
+
+func TestEnabledFast(t *testing.T) {
+	l := New(io.Discard, INFO)
+	for level := Level(-1); level <= ERROR+1; level++ {
+		want := level >= INFO && level <= ERROR
+		if got := l.EnabledFast(level); got != want {
+			t.Errorf("EnabledFast(%d) = %v, ожидалось %v", level, got, want)
+		}
+	}
+
+	l.SetLevel(TRACE)
+	if !l.EnabledFast(TRACE) {
+		t.Error("маска не обновлена после SetLevel")
+	}
+	if c := l.With("k", "v"); !c.EnabledFast(TRACE) {
+		t.Error("маска не скопирована в производный логгер")
+	}
+}
+
+func BenchmarkIsLevel(b *testing.B) {
+	l := New(io.Discard, INFO)
+	var n int
+	for i := 0; i < b.N; i++ {
+		if l.IsLevel(DEBUG) {
+			n++
+		}
+	}
+	_ = n
+}
+
+func BenchmarkEnabledFast(b *testing.B) {
+	l := New(io.Discard, INFO)
+	var n int
+	for i := 0; i < b.N; i++ {
+		if l.EnabledFast(DEBUG) {
+			n++
+		}
+	}
+	_ = n
+}
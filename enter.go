@@ -0,0 +1,43 @@
+package log
+
+import "time"
+
+// Отступ одного уровня вложенности.
+const indentStep = "  "
+
+// Enter отмечает вход в именованную операцию и увеличивает отступ
+// последующих сообщений логгера.
+//
+// Пишет сообщение "→ name" уровня TRACE и возвращает функцию, которая
+// пишет сообщение "← name" с длительностью операции и возвращает отступ
+// на прежний уровень. Вызовы можно вкладывать друг в друга, в текстовом
+// формате сообщения внутри операции смещаются вправо на два пробела за
+// каждый уровень вложенности:
+//
+//	defer l.Enter("загрузка конфигурации")()
+//
+// Глубина вложенности общая для всех горутин, пишущих в этот логгер, и
+// безопасна для одновременного использования. Для независимых отступов в
+// параллельных потоках используйте отдельные логгеры, например: Logger.With().
+// Возвращённую функцию следует вызвать ровно один раз.
+func (l *Logger) Enter(name string) func() {
+	var start = time.Now()
+
+	if l.EnabledFast(TRACE) {
+		l.write(TRACE, "→ ", name)
+	}
+	l.depth.Add(1)
+
+	return func() {
+		l.depth.Add(-1)
+		if l.EnabledFast(TRACE) {
+			l.write(TRACE, "← ", name, " (", time.Since(start), ")")
+		}
+	}
+}
+
+// Enter отмечает вход в именованную операцию дефолтного логгера.
+// Подробнее смотрите: Logger.Enter().
+func Enter(name string) func() {
+	return std.Enter(name)
+}
@@ -0,0 +1,52 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEnter(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	exit := l.Enter("внешняя")
+	l.Info("a")
+	inner := l.Enter("внутренняя")
+	l.Info("b")
+	inner()
+	exit()
+	l.Info("c")
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	want := []string{"→ внешняя", "  a", "  → внутренняя", "    b", "  ← внутренняя (", "← внешняя (", "c"}
+	if len(lines) != len(want) {
+		t.Fatalf("неверный вывод:\n%s", buf.String())
+	}
+	for i := range want {
+		if !strings.HasPrefix(lines[i], want[i]) {
+			t.Errorf("строка %d: %q, ожидалось начало %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestEnterWrapWidth(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.WrapWidth = 12
+
+	exit := l.Enter("op")
+	l.Info("inside")
+	l.Info("один два три")
+	exit()
+
+	lines := strings.Split(buf.String(), "\n")
+	want := []string{"→ op", "  inside", "  один два", "  три"}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("строка %d: %q, ожидалось %q", i, lines[i], want[i])
+		}
+	}
+}
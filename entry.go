@@ -0,0 +1,168 @@
+package log
+
+import "sort"
+
+// WithField создаёт сообщение с полем key=value для последующей записи.
+//
+// Возвращает Entry, у которого есть те же методы записи, что и у логгера:
+// Info(), Warnf() и т.д. Поля Entry добавляются к полям логгера только в
+// сообщениях, записанных через этот Entry. Сам логгер не изменяется и не
+// копируется, поэтому это дешевле, чем Logger.With():
+//
+//	l.WithField("user", id).WithField("ip", addr).Info("вход выполнен")
+//
+// Для многократного использования набора полей используйте Logger.With().
+func (l *Logger) WithField(key string, value interface{}) *Entry {
+	return &Entry{Logger: l, Fields: []Field{{Key: key, Value: value}}}
+}
+
+// WithFields создаёт сообщение с несколькими полями для последующей записи.
+// Поля упорядочиваются по ключу. Подробнее смотрите: Logger.WithField().
+func (l *Logger) WithFields(fields map[string]interface{}) *Entry {
+	return (&Entry{Logger: l}).WithFields(fields)
+}
+
+// WithField создаёт сообщение дефолтного логгера с полем key=value.
+// Подробнее смотрите: Logger.WithField().
+func WithField(key string, value interface{}) *Entry {
+	return std.WithField(key, value)
+}
+
+// WithFields создаёт сообщение дефолтного логгера с несколькими полями.
+// Подробнее смотрите: Logger.WithFields().
+func WithFields(fields map[string]interface{}) *Entry {
+	return std.WithFields(fields)
+}
+
+// WithField возвращает новый Entry с дополнительным полем key=value.
+// Исходный Entry не изменяется, поэтому из одного Entry можно получить
+// несколько независимых.
+func (e *Entry) WithField(key string, value interface{}) *Entry {
+	c := *e
+	c.Fields = mergeFields(e.Fields, []Field{{Key: key, Value: value}}, e.Logger.AllowDuplicateKeys)
+	return &c
+}
+
+// WithFields возвращает новый Entry с дополнительными полями.
+// Поля упорядочиваются по ключу. Исходный Entry не изменяется.
+func (e *Entry) WithFields(fields map[string]interface{}) *Entry {
+	var keys = make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var extra = make([]Field, len(keys))
+	for i, k := range keys {
+		extra[i] = Field{Key: k, Value: fields[k]}
+	}
+
+	c := *e
+	c.Fields = mergeFields(e.Fields, extra, e.Logger.AllowDuplicateKeys)
+	return &c
+}
+
+// Error выводит сообщение об ошибке с полями Entry.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (e *Entry) Error(v ...interface{}) {
+	if !e.Logger.EnabledFast(ERROR) {
+		return
+	}
+
+	e.Logger.emit(ERROR, message{mode: 's', args: v}, e.Fields)
+}
+
+// Warn выводит предупреждение с полями Entry.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (e *Entry) Warn(v ...interface{}) {
+	if !e.Logger.EnabledFast(WARN) {
+		return
+	}
+
+	e.Logger.emit(WARN, message{mode: 's', args: v}, e.Fields)
+}
+
+// Info выводит информационное сообщение с полями Entry.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (e *Entry) Info(v ...interface{}) {
+	if !e.Logger.EnabledFast(INFO) {
+		return
+	}
+
+	e.Logger.emit(INFO, message{mode: 's', args: v}, e.Fields)
+}
+
+// Debug выводит отладочное сообщение с полями Entry.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (e *Entry) Debug(v ...interface{}) {
+	if !e.Logger.EnabledFast(DEBUG) {
+		return
+	}
+
+	e.Logger.emit(DEBUG, message{mode: 's', args: v}, e.Fields)
+}
+
+// Trace выводит произвольное сообщение с полями Entry.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (e *Entry) Trace(v ...interface{}) {
+	if !e.Logger.EnabledFast(TRACE) {
+		return
+	}
+
+	e.Logger.emit(TRACE, message{mode: 's', args: v}, e.Fields)
+}
+
+// Errorf выводит форматированное сообщение об ошибке с полями Entry.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (e *Entry) Errorf(format string, v ...interface{}) {
+	if !e.Logger.EnabledFast(ERROR) {
+		return
+	}
+
+	e.Logger.emit(ERROR, message{mode: 'f', format: format, args: v}, e.Fields)
+}
+
+// Warnf выводит форматированное предупреждение с полями Entry.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (e *Entry) Warnf(format string, v ...interface{}) {
+	if !e.Logger.EnabledFast(WARN) {
+		return
+	}
+
+	e.Logger.emit(WARN, message{mode: 'f', format: format, args: v}, e.Fields)
+}
+
+// Infof выводит форматированное информационное сообщение с полями Entry.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (e *Entry) Infof(format string, v ...interface{}) {
+	if !e.Logger.EnabledFast(INFO) {
+		return
+	}
+
+	e.Logger.emit(INFO, message{mode: 'f', format: format, args: v}, e.Fields)
+}
+
+// Debugf выводит форматированное отладочное сообщение с полями Entry.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (e *Entry) Debugf(format string, v ...interface{}) {
+	if !e.Logger.EnabledFast(DEBUG) {
+		return
+	}
+
+	e.Logger.emit(DEBUG, message{mode: 'f', format: format, args: v}, e.Fields)
+}
+
+// Tracef выводит форматированное произвольное сообщение с полями Entry.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (e *Entry) Tracef(format string, v ...interface{}) {
+	if !e.Logger.EnabledFast(TRACE) {
+		return
+	}
+
+	e.Logger.emit(TRACE, message{mode: 'f', format: format, args: v}, e.Fields)
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestWithField(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf).With("app", "api")
+	l.Head = false
+
+	base := l.WithField("user", 7)
+	a := base.WithField("ip", "10.0.0.1")
+	b := base.WithField("ip", "10.0.0.2").WithField("user", 8)
+
+	a.Info("первое")
+	b.Warnf("второе: %d", 2)
+	base.Debug("третье")
+	l.WithFields(map[string]interface{}{"b": 2, "a": 1}).Trace("четвёртое")
+	l.Info("без полей")
+
+	want := "первое app=api user=7 ip=10.0.0.1\n" +
+		"второе: 2 app=api user=8 ip=10.0.0.2\n" +
+		"третье app=api user=7\n" +
+		"четвёртое app=api a=1 b=2\n" +
+		"без полей app=api\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	l.SetLevel(WARN)
+	base.Info("скрыто")
+	if buf.Len() != 0 {
+		t.Errorf("сообщение должно быть отфильтровано уровнем: %q", buf.String())
+	}
+}
+
+func TestEntryConcurrentSetLevel(t *testing.T) {
+	l := New(io.Discard, INFO)
+	w := l.Writer(DEBUG)
+
+	var done = make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			l.WithField("i", i).Info("a")
+			l.WithField("i", i).Debugf("%d", i)
+			w.Write([]byte("b\n"))
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		l.SetLevel(Level(i%2) + DEBUG)
+	}
+	<-done
+}
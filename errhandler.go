@@ -0,0 +1,48 @@
+package log
+
+import (
+	"fmt"
+	"os"
+)
+
+// SetErrorHandler устанавливает обработчик ошибок записи в журнал.
+//
+// Методы Info, Warn, Error и другие не возвращают ошибку, поэтому без
+// обработчика сбой цели вывода (переполненный диск, разорванное соединение)
+// остался бы незамеченным. Обработчик вызывается при каждой ошибке записи
+// сообщения любым методом логгера, включая ошибки хуков и сброса буфера.
+// Вызывается вне мьютекса логгера, однако запись в этот же логгер из
+// обработчика может привести к бесконечной рекурсии, если цель вывода
+// продолжает возвращать ошибку.
+//
+// Значение nil отключает обработчик: ошибки только возвращаются из
+// внутренних методов записи.
+//
+// По умолчанию: ошибка выводится в os.Stderr.
+func (l *Logger) SetErrorHandler(h func(error)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onError = h
+}
+
+// SetErrorHandler устанавливает обработчик ошибок записи дефолтного логгера.
+// Подробнее смотрите: Logger.SetErrorHandler().
+func SetErrorHandler(h func(error)) {
+	std.SetErrorHandler(h)
+}
+
+// Обработчик ошибок записи по умолчанию.
+func defaultErrorHandler(err error) {
+	fmt.Fprintln(os.Stderr, "log: write failed:", err)
+}
+
+// Передать ошибку записи обработчику.
+func (l *Logger) handleError(err error) {
+	l.mu.Lock()
+	var h = l.onError
+	l.mu.Unlock()
+
+	if h != nil {
+		h(err)
+	}
+}
@@ -0,0 +1,25 @@
+package log
+
+import "testing"
+
+func TestSetErrorHandler(t *testing.T) {
+	l := NewTestLogger(failWriter{})
+
+	var got []string
+	l.SetErrorHandler(func(err error) { got = append(got, err.Error()) })
+	l.Info("a")
+	l.WithField("k", 1).Warn("b")
+	l.SetLevel(INFO)
+	l.Debug("c")
+
+	if len(got) != 2 || got[0] != "сбой записи" || got[1] != "сбой записи" {
+		t.Errorf("обработчик получил %q, ожидалось две ошибки записи", got)
+	}
+
+	got = nil
+	l.SetErrorHandler(nil)
+	l.Info("a")
+	if got != nil {
+		t.Errorf("отключённый обработчик вызван: %q", got)
+	}
+}
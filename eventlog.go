@@ -0,0 +1,127 @@
+//go:build windows
+
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// Функции журнала событий Windows.
+var (
+	advapi32                  = syscall.NewLazyDLL("advapi32.dll")
+	procRegisterEventSource   = advapi32.NewProc("RegisterEventSourceW")
+	procDeregisterEventSource = advapi32.NewProc("DeregisterEventSource")
+	procReportEvent           = advapi32.NewProc("ReportEventW")
+)
+
+// Типы событий журнала Windows.
+const (
+	eventlogError       = 0x0001 // EVENTLOG_ERROR_TYPE
+	eventlogWarning     = 0x0002 // EVENTLOG_WARNING_TYPE
+	eventlogInformation = 0x0004 // EVENTLOG_INFORMATION_TYPE
+)
+
+// Идентификатор записываемых событий.
+const eventlogID = 1
+
+// WindowsEventWriter пишет журнал в журнал событий Windows (Event Log).
+//
+// Реализует LevelWriter, поэтому уровень важности каждого сообщения
+// логгера передаётся как тип события: ERROR - ошибка, WARN -
+// предупреждение, остальные уровни - сведения. Время и источник событий
+// журнал добавляет сам, поэтому заголовок логгера обычно отключают:
+//
+//	w, err := log.NewWindowsEventWriter("MyService")
+//	l := log.New(w, log.INFO)
+//	l.Head = false
+//
+// Чтобы просмотр событий показывал текст без предупреждения об
+// отсутствующем описании, источник нужно зарегистрировать в реестре,
+// обычно при установке службы. Доступен только в Windows.
+// Безопасен для одновременного использования из нескольких горутин.
+type WindowsEventWriter struct {
+	mu     sync.Mutex
+	handle uintptr // Дескриптор источника событий.
+}
+
+// NewWindowsEventWriter открывает источник событий source в журнале
+// приложений. По умолчанию source - имя исполняемого файла без
+// расширения.
+func NewWindowsEventWriter(source string) (*WindowsEventWriter, error) {
+	if source == "" {
+		source = strings.TrimSuffix(filepath.Base(os.Args[0]), filepath.Ext(os.Args[0]))
+	}
+
+	name, err := syscall.UTF16PtrFromString(source)
+	if err != nil {
+		return nil, err
+	}
+
+	h, _, err := procRegisterEventSource.Call(0, uintptr(unsafe.Pointer(name)))
+	if h == 0 {
+		return nil, err
+	}
+
+	return &WindowsEventWriter{handle: h}, nil
+}
+
+// Write записывает событие с уровнем важности INFO.
+func (w *WindowsEventWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(INFO, p)
+}
+
+// WriteLevel записывает событие с уровнем важности level.
+// Завершающий перевод строки в текст события не включается, а нулевые
+// символы, недопустимые в тексте события, заменяются пробелами.
+func (w *WindowsEventWriter) WriteLevel(level Level, p []byte) (int, error) {
+	var text = strings.TrimSuffix(strings.TrimSuffix(string(p), "\n"), "\r")
+	msg, err := syscall.UTF16PtrFromString(strings.ReplaceAll(text, "\x00", " "))
+	if err != nil {
+		return 0, err
+	}
+
+	var kind = eventlogInformation
+	switch level {
+	case ERROR:
+		kind = eventlogError
+	case WARN:
+		kind = eventlogWarning
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.handle == 0 {
+		return 0, os.ErrClosed
+	}
+
+	r, _, err := procReportEvent.Call(w.handle, uintptr(kind), 0, eventlogID, 0, 1, 0, uintptr(unsafe.Pointer(&msg)), 0)
+	if r == 0 {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close закрывает источник событий. Повторный вызов ничего не делает.
+func (w *WindowsEventWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.handle == 0 {
+		return nil
+	}
+
+	r, _, err := procDeregisterEventSource.Call(w.handle)
+	w.handle = 0
+	if r == 0 {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build windows
+
+package log
+
+import "testing"
+
+func TestWindowsEventWriter(t *testing.T) {
+	w, err := NewWindowsEventWriter("GoLoggerTest")
+	if err != nil {
+		t.Skip("журнал событий недоступен:", err)
+	}
+
+	l := New(w, TRACE)
+	l.Head = false
+	l.Warn("предупреждение")
+	l.Error("ошибка\nвторая строка")
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Errorf("запись в закрытый писатель не вернула ошибку")
+	}
+}
@@ -0,0 +1,78 @@
+package log
+
+import "os"
+
+// Fatal выводит сообщение о фатальной ошибке и завершает работу приложения.
+// Пишет сообщение уровня ERROR и вызывает: os.Exit(1). Код завершения и
+// функцию завершения можно изменить: Logger.ExitCode, Logger.ExitFunc.
+// Отложенные вызовы (defer) при этом не выполняются. Работа приложения
+// завершается, даже если сообщение не записано из-за уровня важности
+// логируемых сообщений.
+//
+// Буферы целей вывода перед завершением не сбрасываются, а очередь
+// асинхронного логгера не дописывается. Если цель вывода буферизована,
+// вызовите Logger.Flush() перед намеренным завершением приложения.
+func (l *Logger) Fatal(v ...interface{}) {
+	if l.EnabledFast(ERROR) {
+		l.write(ERROR, v...)
+	}
+	l.fatalExit()
+}
+
+// Fatalf выводит форматированное сообщение о фатальной ошибке и завершает
+// работу приложения. Аргументы обрабатываются как в fmt.Sprintf(). Пишет
+// сообщение уровня ERROR и вызывает: os.Exit(1). См.: Logger.Fatal().
+func (l *Logger) Fatalf(format string, v ...interface{}) {
+	if l.EnabledFast(ERROR) {
+		l.writef(ERROR, format, v...)
+	}
+	l.fatalExit()
+}
+
+// Fatalln выводит сообщение о фатальной ошибке и завершает работу
+// приложения. Аргументы всегда разделяются пробелами, как в
+// fmt.Sprintln(). Пишет сообщение уровня ERROR и вызывает: os.Exit(1).
+// См.: Logger.Fatal().
+func (l *Logger) Fatalln(v ...interface{}) {
+	if l.EnabledFast(ERROR) {
+		l.writeln(ERROR, v...)
+	}
+	l.fatalExit()
+}
+
+// Fatal выводит сообщение о фатальной ошибке и завершает работу приложения.
+// Пишет сообщение уровня ERROR и вызывает: os.Exit(1).
+func Fatal(v ...interface{}) {
+	std.Fatal(v...)
+}
+
+// Fatalf выводит форматированное сообщение о фатальной ошибке и завершает
+// работу приложения. Пишет сообщение уровня ERROR и вызывает: os.Exit(1).
+func Fatalf(format string, v ...interface{}) {
+	std.Fatalf(format, v...)
+}
+
+// Fatalln выводит сообщение о фатальной ошибке и завершает работу
+// приложения. Пишет сообщение уровня ERROR и вызывает: os.Exit(1).
+func Fatalln(v ...interface{}) {
+	std.Fatalln(v...)
+}
+
+// Функция завершения приложения, если Logger.ExitFunc не задана.
+//
+// Заменяется только в тестах пакета, чтобы проверить завершение без
+// остановки процесса тестов. Рабочий код не должен её изменять, для
+// этого есть Logger.ExitFunc.
+var exit = os.Exit
+
+// Завершить работу приложения с кодом ExitCode.
+func (l *Logger) fatalExit() {
+	l.mu.RLock()
+	code, fn := l.ExitCode, l.ExitFunc
+	l.mu.RUnlock()
+
+	if fn == nil {
+		fn = exit
+	}
+	fn(code)
+}
@@ -0,0 +1,44 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFatalExit(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	var codes []int
+	l.ExitFunc = func(code int) { codes = append(codes, code) }
+
+	l.Fatal("a")
+	l.ExitCode = 3
+	l.Fatalf("%s", "b")
+	l.SetLevel(ERROR + 1)
+	l.Fatalln("c")
+
+	if buf.String() != "a\nb\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+	if len(codes) != 3 || codes[0] != 1 || codes[1] != 3 || codes[2] != 3 {
+		t.Errorf("неверные коды завершения: %v", codes)
+	}
+}
+
+func TestFatalDefaultExit(t *testing.T) {
+	var code = -1
+	old := exit
+	exit = func(c int) { code = c }
+	defer func() { exit = old }()
+
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.Fatal("конец")
+
+	if buf.String() != "конец\n" || code != 1 {
+		t.Errorf("вывод %q, код завершения %d", buf.String(), code)
+	}
+}
@@ -0,0 +1,179 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Field описывает одно поле структурированного сообщения: ключ и значение.
+type Field struct {
+	Key   string      // Ключ поля.
+	Value interface{} // Значение поля.
+}
+
+// With создаёт производный логгер, добавляющий к каждому сообщению поле key=value.
+//
+// Производный логгер получает копию всех настроек, уровня и цели вывода
+// исходного логгера. Исходный логгер не изменяется, поэтому вызовы можно
+// объединять в цепочку: l.With("user", id).With("req", reqID).
+//
+// Если поле с таким ключом уже есть, по умолчанию его значение заменяется
+// новым с сохранением порядка полей. Чтобы сохранять все значения,
+// включите Logger.AllowDuplicateKeys.
+//
+// В текстовом формате поля выводятся после сообщения в виде key=value,
+// значения форматируются как %v. В формате JSON поля добавляются в объект
+// сообщения: nil записывается как null, логические и числовые значения -
+// литералами JSON, прочие значения - через encoding/json.
+func (l *Logger) With(key string, value interface{}) *Logger {
+	c := l.Clone()
+	c.fields = mergeFields(c.fields, []Field{{Key: key, Value: value}}, c.AllowDuplicateKeys)
+	return c
+}
+
+// With создаёт производный от дефолтного логгер, добавляющий к каждому
+// сообщению поле key=value.
+func With(key string, value interface{}) *Logger {
+	return std.With(key, value)
+}
+
+// Получить новый срез полей base, дополненный полями extra.
+//
+// Если dup равен false, поле с уже существующим ключом заменяет значение
+// прежнего поля с сохранением порядка. Исходные срезы не изменяются.
+func mergeFields(base, extra []Field, dup bool) []Field {
+	var res = base[:len(base):len(base)]
+	var copied bool
+
+next:
+	for _, f := range extra {
+		if !dup {
+			for i, v := range res {
+				if v.Key == f.Key {
+					if !copied {
+						res = append([]Field(nil), res...)
+						copied = true
+					}
+					res[i].Value = f.Value
+					continue next
+				}
+			}
+		}
+		res = append(res, f)
+		copied = true
+	}
+
+	return res
+}
+
+// Записать поля в текстовом виде: " key=value key=value".
+// Если quote равен true, строковые значения при необходимости
+// заключаются в кавычки. См.: Logger.QuoteStrings. Списки выводятся
+// в виде, заданном compact. См.: Logger.CompactSlices.
+func appendTextFields(buf *[]byte, fields []Field, quote, compact bool) {
+	for _, f := range fields {
+		*buf = append(*buf, ' ')
+		*buf = append(*buf, f.Key...)
+		*buf = append(*buf, '=')
+		if quote {
+			switch v := f.Value.(type) {
+			case string, error, fmt.Stringer:
+				if !isNil(v) {
+					appendLogfmtValue(buf, fmt.Sprint(v))
+					continue
+				}
+			}
+		}
+		if rv, ok := listValue(f.Value); ok {
+			appendTextList(buf, rv, compact)
+			continue
+		}
+		*buf = fmt.Append(*buf, f.Value)
+	}
+}
+
+// Записать значение поля в виде JSON значения.
+func appendJSONValue(buf *[]byte, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		*buf = append(*buf, "null"...)
+	case bool:
+		*buf = strconv.AppendBool(*buf, v)
+	case string:
+		appendJSONString(buf, v)
+	case int:
+		*buf = strconv.AppendInt(*buf, int64(v), 10)
+	case int8:
+		*buf = strconv.AppendInt(*buf, int64(v), 10)
+	case int16:
+		*buf = strconv.AppendInt(*buf, int64(v), 10)
+	case int32:
+		*buf = strconv.AppendInt(*buf, int64(v), 10)
+	case int64:
+		*buf = strconv.AppendInt(*buf, v, 10)
+	case uint:
+		*buf = strconv.AppendUint(*buf, uint64(v), 10)
+	case uint8:
+		*buf = strconv.AppendUint(*buf, uint64(v), 10)
+	case uint16:
+		*buf = strconv.AppendUint(*buf, uint64(v), 10)
+	case uint32:
+		*buf = strconv.AppendUint(*buf, uint64(v), 10)
+	case uint64:
+		*buf = strconv.AppendUint(*buf, v, 10)
+	case float32:
+		appendJSONFloat(buf, float64(v), 32)
+	case float64:
+		appendJSONFloat(buf, v, 64)
+	default:
+		if isNil(v) {
+			*buf = append(*buf, "null"...)
+			return
+		}
+
+		switch v := v.(type) {
+		case json.Marshaler:
+			appendJSONMarshal(buf, v)
+		case error:
+			appendJSONString(buf, v.Error())
+		case fmt.Stringer:
+			appendJSONString(buf, v.String())
+		default:
+			appendJSONMarshal(buf, v)
+		}
+	}
+}
+
+// Записать число с плавающей точкой.
+// Значения NaN и бесконечности не представимы в JSON и пишутся строкой.
+func appendJSONFloat(buf *[]byte, f float64, bits int) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		appendJSONString(buf, strconv.FormatFloat(f, 'g', -1, bits))
+		return
+	}
+	*buf = strconv.AppendFloat(*buf, f, 'g', -1, bits)
+}
+
+// Записать значение через encoding/json.
+// Если значение не сериализуется, пишется его строковое представление.
+func appendJSONMarshal(buf *[]byte, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		appendJSONString(buf, fmt.Sprint(v))
+		return
+	}
+	*buf = append(*buf, b...)
+}
+
+// Проверить, является ли значение нулевым указателем или аналогом.
+func isNil(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestWithJSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.SetFormat(FormatJSON)
+
+	var nilErr *errorString
+	l.With("nil", nil).
+		With("ok", true).
+		With("n", 42).
+		With("f", 1.5).
+		With("s", "text").
+		With("err", errors.New("boom")).
+		With("nilptr", nilErr).
+		With("list", []int{1, 2}).
+		Info("сообщение")
+
+	want := `,"msg":"сообщение","nil":null,"ok":true,"n":42,"f":1.5,"s":"text","err":"boom","nilptr":null,"list":[1,2]}` + "\n"
+	if !bytes.HasSuffix(buf.Bytes(), []byte(want)) {
+		t.Errorf("неверные поля:\n%s\nожидалось окончание:\n%s", buf.String(), want)
+	}
+}
+
+func TestWithText(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Head = false
+
+	child := l.With("nil", nil).With("ok", false)
+	child.Info("сообщение")
+	l.Info("без полей")
+
+	if want := "сообщение nil=<nil> ok=false\nбез полей\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestWithDuplicateKeys(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Head = false
+
+	base := l.With("id", 1).With("user", "bob")
+	base.With("id", 2).Info("замена")
+	base.Info("исходный")
+
+	l.AllowDuplicateKeys = true
+	l.With("id", 1).With("id", 2).Info("повтор")
+
+	want := "замена id=2 user=bob\nисходный id=1 user=bob\nповтор id=1 id=2\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+type errorString struct{ s string }
+
+func (e *errorString) Error() string { return e.s }
+
+func TestQuoteStrings(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.QuoteStrings = true
+
+	l.With("user", "иван петров").
+		With("id", "42").
+		With("empty", "").
+		With("err", &errorString{`нет "файла"`}).
+		With("n", 5).
+		Info("вход выполнен")
+
+	want := `вход выполнен user="иван петров" id=42 empty="" err="нет \"файла\"" n=5` + "\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	l.QuoteBody = true
+	l.Info("вход выполнен")
+	l.Info("готово")
+	if buf.String() != "\"вход выполнен\"\nготово\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
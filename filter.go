@@ -0,0 +1,32 @@
+package log
+
+// SetFilter устанавливает фильтр сообщений журнала.
+//
+// Фильтр получает уровень и текст каждого сообщения, прошедшего проверку
+// уровня важности, до построения заголовка и добавления полей. Если
+// фильтр возвращает false, сообщение отбрасывается, иначе возвращённая
+// строка заменяет текст сообщения. Это единое место для удаления
+// секретов и персональных данных из журнала всего приложения:
+//
+//	re := regexp.MustCompile(`token=\S+`)
+//	log.SetFilter(func(level log.Level, msg string) (string, bool) {
+//		return re.ReplaceAllString(msg, "token=***"), true
+//	})
+//
+// Фильтр применяется раньше заглушек (Mute) и подавления повторов
+// (SetDedup), поэтому они видят уже изменённый текст. Поля сообщения
+// фильтр не получает. Фильтр вызывается под мьютексом логгера и может
+// вызываться одновременно из нескольких горутин, поэтому не должен писать
+// в этот же логгер. Производные логгеры (With, Clone) получают фильтр
+// исходного на момент создания. Значение nil отключает фильтр.
+func (l *Logger) SetFilter(f func(level Level, msg string) (string, bool)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.filter = f
+}
+
+// SetFilter устанавливает фильтр сообщений дефолтного логгера.
+// Подробнее смотрите: Logger.SetFilter().
+func SetFilter(f func(level Level, msg string) (string, bool)) {
+	std.SetFilter(f)
+}
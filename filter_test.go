@@ -0,0 +1,30 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSetFilter(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(DEBUG)
+
+	l.SetFilter(func(level Level, msg string) (string, bool) {
+		if level == DEBUG {
+			return msg, false
+		}
+		return strings.ReplaceAll(msg, "секрет", "***"), true
+	})
+	l.Info("пароль: секрет")
+	l.Debug("отладка")
+	l.With("k", "секрет").Warn("поле")
+	l.SetFilter(nil)
+	l.Debug("секрет")
+
+	if want := "пароль: ***\nполе k=секрет\nсекрет\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
@@ -0,0 +1,104 @@
+package log
+
+import (
+	"io"
+	"os"
+)
+
+// Цель вывода с внутренним буфером.
+type flusher interface {
+	Flush() error
+}
+
+// Сбросить буферы всех целей вывода, которые это поддерживают.
+// Вызывается под мьютексом логгера или мьютексом записи.
+// Возвращает первую возникшую ошибку.
+func (l *Logger) flushOutputs() error {
+	var err error
+	var done []io.Writer
+
+next:
+	for _, w := range append(append([]io.Writer{l.out}, l.outs...), l.levelOut[:]...) {
+		f, ok := w.(flusher)
+		if !ok {
+			continue
+		}
+		for _, v := range done {
+			if v == w {
+				continue next
+			}
+		}
+		done = append(done, w)
+
+		if e := f.Flush(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Flush сбрасывает буферы целей вывода логгера, которые это поддерживают:
+// реализуют метод Flush() error, например *bufio.Writer, RotatingFile или
+// AsyncWriter. Для асинхронного логгера дожидается записи всей очереди.
+// Для целей без буфера, например os.Stderr, ничего не делает. Перед
+// сбросом записывает итоговые строки повторов, подавленных SetDedup().
+// Возвращает первую возникшую ошибку.
+//
+// Вызовы os.Exit(), в том числе из Logger.Fatal(), не выполняют сброс, а
+// отложенные вызовы при этом не срабатывают. Вызывайте Flush сами перед
+// намеренным завершением приложения.
+func (l *Logger) Flush() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err = l.flushDedup()
+	if e := l.flushOutputs(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+// Close сбрасывает буферы и закрывает цели вывода логгера, которые
+// реализуют io.Closer, в том числе файлы SetLevelDir. Стандартные потоки
+// os.Stdout и os.Stderr не закрываются. AsyncWriter дописывает очередь и
+// останавливает фоновую горутину, но не закрывает свою цель вывода.
+// Возвращает первую возникшую ошибку.
+//
+// После закрытия логгер не следует использовать для записи.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err = l.flushDedup()
+	if e := l.flushOutputs(); err == nil {
+		err = e
+	}
+	var done []io.Writer
+
+next:
+	for _, w := range append(append([]io.Writer{l.out}, l.outs...), l.levelOut[:]...) {
+		c, ok := w.(io.Closer)
+		if !ok || w == io.Writer(os.Stdout) || w == io.Writer(os.Stderr) {
+			continue
+		}
+		for _, v := range done {
+			if v == w {
+				continue next
+			}
+		}
+		done = append(done, w)
+
+		if e := c.Close(); err == nil {
+			err = e
+		}
+	}
+
+	for _, f := range l.levelFiles {
+		f.Close()
+	}
+	l.levelFiles = nil
+
+	return err
+}
@@ -0,0 +1,59 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestFlushEvery(t *testing.T) {
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	l := New(bw, TRACE)
+	l.Head = false
+	l.FlushEvery = 2
+
+	l.Info("a")
+	if buf.Len() != 0 {
+		t.Fatalf("буфер сброшен раньше времени: %q", buf.String())
+	}
+	l.Info("b")
+	if buf.String() != "a\nb\n" {
+		t.Fatalf("буфер не сброшен: %q", buf.String())
+	}
+	l.Info("c")
+	if buf.String() != "a\nb\n" {
+		t.Fatalf("буфер сброшен раньше времени: %q", buf.String())
+	}
+}
+
+// Цель вывода, отслеживающая закрытие.
+type closeWriter struct {
+	bytes.Buffer
+	closed int
+}
+
+func (w *closeWriter) Close() error {
+	w.closed++
+	return nil
+}
+
+func TestLoggerClose(t *testing.T) {
+	var w closeWriter
+	var b = bufio.NewWriter(&w)
+	l := NewTestLogger(b)
+	l.Head = false
+	l.AddOutput(&w)
+	l.AddOutput(os.Stderr)
+	l.SetLevelOutput(WARN, &w)
+
+	l.Info("раз")
+	if err := l.Flush(); err != nil || w.String() != "раз\nраз\n" {
+		t.Fatalf("буфер не сброшен: %q %v", w.String(), err)
+	}
+
+	if err := l.Close(); err != nil || w.closed != 1 {
+		t.Errorf("цель вывода должна закрываться один раз: %d %v", w.closed, err)
+	}
+}
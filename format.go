@@ -0,0 +1,329 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Format описывает формат вывода сообщений журнала.
+//
+// Возможные значения:
+//
+// - FormatText - Человекочитаемый текст с заголовком и раскраской.
+//
+// - FormatJSON - Один JSON объект на строку (NDJSON) для машинной обработки.
+//
+// - FormatGELF - Сообщения в формате GELF для Graylog.
+//
+// - FormatLogfmt - Пары key=value в формате logfmt.
+type Format int32
+
+// Форматы вывода сообщений журнала.
+const (
+
+	// FormatText - Человекочитаемый текст с заголовком и раскраской.
+	// Настройки заголовка и цвета применяются только в этом формате.
+	// Используется по умолчанию.
+	FormatText Format = iota
+
+	// FormatJSON - Один JSON объект на строку с ключами: time, level, msg.
+	// Время записывается в формате RFC 3339 с учётом флага UTC.
+	// Раскраска и настройки заголовка в этом формате игнорируются.
+	FormatJSON
+
+	// FormatGELF - Сообщения GELF 1.1 для прямой загрузки в Graylog.
+	// Подробнее смотрите: GELFFormatter.
+	FormatGELF
+
+	// FormatLogfmt - Пары key=value в одну строку, удобные для grep и lnav.
+	// Подробнее смотрите: LogfmtFormatter.
+	FormatLogfmt
+)
+
+// SetFormat устанавливает формат вывода сообщений журнала.
+// Это сокращение для установки встроенного форматтера: log.SetFormatter().
+// Доступные значения Format смотрите в константах пакета.
+func (l *Logger) SetFormat(f Format) {
+	l.SetFormatter(formatter(f))
+}
+
+// Получить встроенный форматтер формата f.
+// Для неизвестных значений возвращает TextFormatter.
+func formatter(f Format) Formatter {
+	switch f {
+	case FormatJSON:
+		return JSONFormatter{}
+	case FormatGELF:
+		return GELFFormatter{}
+	case FormatLogfmt:
+		return LogfmtFormatter{}
+	default:
+		return TextFormatter{}
+	}
+}
+
+// Названия форматов вывода.
+var formatNames = [...]string{
+	FormatText:   "text",
+	FormatJSON:   "json",
+	FormatGELF:   "gelf",
+	FormatLogfmt: "logfmt",
+}
+
+// String возвращает название формата: text, json, gelf или logfmt.
+// Для значений вне диапазона возвращает текст вида: Format(7).
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return "Format(" + strconv.Itoa(int(f)) + ")"
+	}
+	return formatNames[f]
+}
+
+// MarshalText возвращает название формата. Реализует
+// encoding.TextMarshaler, поэтому формат записывается в файлы
+// конфигурации строкой.
+func (f Format) MarshalText() ([]byte, error) {
+	if f < 0 || int(f) >= len(formatNames) {
+		return nil, fmt.Errorf("log: invalid format %d", int32(f))
+	}
+	return []byte(formatNames[f]), nil
+}
+
+// UnmarshalText устанавливает формат по его названию без учёта регистра:
+// "text", "json", "gelf" или "logfmt". Реализует encoding.TextUnmarshaler.
+func (f *Format) UnmarshalText(text []byte) error {
+	for i, v := range formatNames {
+		if strings.EqualFold(string(text), v) {
+			*f = Format(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("log: unknown format %q, expected one of: text, json, gelf, logfmt", text)
+}
+
+// Formatter описывает способ преобразования сообщения журнала в строку.
+//
+// Реализуйте этот интерфейс, чтобы использовать собственный формат вывода,
+// и установите его с помощью Logger.SetFormatter(). Метод Format должен
+// дописать в буфер buf готовую строку журнала вместе с завершающим
+// переводом строки. Вызывается под мьютексом логгера, захваченным на
+// чтение, поэтому не должен обращаться к методам логгера, записывающим
+// сообщения или меняющим его состояние. Может вызываться одновременно из
+// нескольких горутин.
+type Formatter interface {
+	Format(buf *[]byte, e Entry)
+}
+
+// Entry описывает одно сообщение журнала.
+//
+// Передаётся форматтеру для вывода сообщения. Также используется для
+// записи сообщений с дополнительными полями, см.: Logger.WithField().
+type Entry struct {
+	Logger    *Logger       // Логгер, записывающий сообщение. Источник настроек вывода.
+	Level     Level         // Уровень важности сообщения.
+	Time      time.Time     // Время записи. Уже приведено к Logger.Location или UTC.
+	Message   string        // Текст сообщения.
+	Fields    []Field       // Поля сообщения. Не изменяйте этот срез.
+	Stack     []Frame       // Стек вызовов, если он был собран. См.: Logger.StackTrace.
+	Depth     int           // Глубина вложенности. См.: Logger.Enter().
+	Caller    string        // Место вызова: file.go:42, если включен Logger.HeadCaller.
+	Goroutine uint64        // Номер горутины, если включен Logger.HeadGoroutine.
+	Sequence  uint64        // Порядковый номер, если включен Logger.HeadSequence.
+	Hostname  string        // Имя хоста, если включен Logger.HeadHostname.
+	PID       int           // Идентификатор процесса, если включен Logger.HeadPID.
+	Delta     time.Duration // Время с предыдущего сообщения, если включен Logger.HeadDelta.
+}
+
+// SetFormatter устанавливает форматтер сообщений журнала.
+// Значение nil восстанавливает форматтер по умолчанию: TextFormatter.
+func (l *Logger) SetFormatter(f Formatter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.formatter = f
+}
+
+// TextFormatter выводит сообщения в виде человекочитаемого текста.
+//
+// Это форматтер по умолчанию. Вид заголовка, раскраска, перенос строк и
+// прочее оформление определяются настройками логгера из Entry.Logger.
+type TextFormatter struct{}
+
+// Format дописывает в буфер сообщение в текстовом виде.
+func (TextFormatter) Format(buf *[]byte, e Entry) {
+	l := e.Logger
+
+	// Шапка:
+	var start = len(*buf)
+	if l.Head {
+		l.writeHeader(buf, e)
+	}
+
+	// Префикс:
+	if l.prefix != "" {
+		if l.Color {
+			*buf = append(*buf, colorHeader...)
+			*buf = append(*buf, l.prefix...)
+			*buf = append(*buf, colorClear...)
+		} else {
+			*buf = append(*buf, l.prefix...)
+		}
+		*buf = append(*buf, ' ')
+	}
+
+	// Тело:
+	var body = len(*buf)
+	for i := 0; i < e.Depth; i++ {
+		*buf = append(*buf, indentStep...)
+	}
+	var text = len(*buf) // Начало текста после отступа вложенности Enter().
+	var on = l.bodyColor(e.Level)
+	if !l.Color {
+		on = ""
+	}
+	if l.QuoteBody && needsQuote(e.Message) {
+		*buf = strconv.AppendQuote(*buf, e.Message)
+	} else if l.Color && len(l.highlights) > 0 {
+		l.appendHighlighted(buf, e.Message, on)
+	} else {
+		*buf = append(*buf, e.Message...)
+	}
+	if len(e.Fields) > 0 {
+		appendTextFields(buf, e.Fields, l.QuoteStrings, l.CompactSlices)
+	}
+	if l.WrapWidth > 0 {
+		var indent = displayWidth(string((*buf)[start:text]))
+		var wrapped = wrapText(string((*buf)[text:]), l.WrapWidth-indent, strings.Repeat(" ", indent))
+		*buf = append((*buf)[:text], wrapped...)
+	} else if l.IndentMultiline {
+		var indent = l.MultilinePrefix
+		if indent == "" {
+			indent = strings.Repeat(" ", displayWidth(string((*buf)[start:text])))
+		}
+		indentTail(buf, text, indent)
+	}
+	if on != "" {
+		colorizeTail(buf, body, on)
+	}
+
+	// Стек:
+	if len(e.Stack) > 0 {
+		l.writeStack(buf, e.Stack)
+	}
+
+	*buf = append(*buf, lineEnding(e)...)
+}
+
+// Получить завершение строки сообщения. См.: Logger.LineEnding.
+func lineEnding(e Entry) string {
+	if e.Logger == nil {
+		return "\n"
+	}
+	return e.Logger.LineEnding
+}
+
+// JSONFormatter выводит каждое сообщение отдельным JSON объектом в строке.
+//
+// Объект содержит ключи: time (RFC 3339), level, msg, host, pid, caller,
+// goroutine и seq (если включены Logger.HeadHostname, Logger.HeadPID,
+// Logger.HeadCaller, Logger.HeadGoroutine и Logger.HeadSequence), затем
+// поля сообщения и, если был собран, стек вызовов в ключе stack.
+// Раскраска и остальные настройки заголовка логгера не применяются.
+type JSONFormatter struct{}
+
+// Format дописывает в буфер сообщение в виде JSON объекта.
+func (JSONFormatter) Format(buf *[]byte, e Entry) {
+	*buf = append(*buf, `{"time":"`...)
+	*buf = e.Time.AppendFormat(*buf, time.RFC3339Nano)
+	*buf = append(*buf, `","level":"`...)
+	*buf = append(*buf, levelName(e.Level)...)
+	*buf = append(*buf, `","msg":`...)
+	appendJSONString(buf, e.Message)
+	if e.Hostname != "" {
+		*buf = append(*buf, `,"host":`...)
+		appendJSONString(buf, e.Hostname)
+	}
+	if e.PID != 0 {
+		*buf = append(*buf, `,"pid":`...)
+		*buf = strconv.AppendInt(*buf, int64(e.PID), 10)
+	}
+	if e.Caller != "" {
+		*buf = append(*buf, `,"caller":`...)
+		appendJSONString(buf, e.Caller)
+	}
+	if e.Goroutine != 0 {
+		*buf = append(*buf, `,"goroutine":`...)
+		*buf = strconv.AppendUint(*buf, e.Goroutine, 10)
+	}
+	if e.Sequence != 0 {
+		*buf = append(*buf, `,"seq":`...)
+		*buf = strconv.AppendUint(*buf, e.Sequence, 10)
+	}
+	for _, f := range e.Fields {
+		*buf = append(*buf, ',')
+		appendJSONString(buf, f.Key)
+		*buf = append(*buf, ':')
+		appendJSONValue(buf, f.Value)
+	}
+	if len(e.Stack) > 0 {
+		*buf = append(*buf, `,"stack":`...)
+		appendJSONStack(buf, e.Stack)
+	}
+	*buf = append(*buf, '}')
+	*buf = append(*buf, lineEnding(e)...)
+}
+
+// Получить название уровня логирования.
+func levelName(level Level) string {
+	switch level {
+	case INFO:
+		return "INFO"
+	case WARN:
+		return "WARN"
+	case TRACE:
+		return "TRACE"
+	case DEBUG:
+		return "DEBUG"
+	default:
+		return "ERROR"
+	}
+}
+
+// Записать строку в виде JSON строки с экранированием.
+func appendJSONString(buf *[]byte, s string) {
+	const hex = "0123456789abcdef"
+
+	*buf = append(*buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				*buf = append(*buf, '\\', c)
+			case c == '\n':
+				*buf = append(*buf, '\\', 'n')
+			case c == '\r':
+				*buf = append(*buf, '\\', 'r')
+			case c == '\t':
+				*buf = append(*buf, '\\', 't')
+			case c < 0x20:
+				*buf = append(*buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			default:
+				*buf = append(*buf, c)
+			}
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			*buf = append(*buf, `\ufffd`...)
+		} else {
+			*buf = append(*buf, s[i:i+size]...)
+		}
+		i += size
+	}
+	*buf = append(*buf, '"')
+}
@@ -0,0 +1,83 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatJSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.SetFormat(FormatJSON)
+
+	msg := "кавычки \" слэш \\ перевод\nстроки \x01 и \xff"
+	l.Warn(msg)
+
+	var v map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+		t.Fatalf("некорректный JSON %q: %v", buf.String(), err)
+	}
+	if v["level"] != "WARN" {
+		t.Errorf("неверный уровень: %q", v["level"])
+	}
+	if v["msg"] != "кавычки \" слэш \\ перевод\nстроки \x01 и \ufffd" {
+		t.Errorf("неверное сообщение: %q", v["msg"])
+	}
+	if bytes.Contains(buf.Bytes(), []byte("\x1b[")) {
+		t.Error("JSON не должен содержать управляющих ANSI символов")
+	}
+}
+
+// Простейший пользовательский форматтер.
+type levelMsgFormatter struct{}
+
+func (levelMsgFormatter) Format(buf *[]byte, e Entry) {
+	*buf = append(*buf, levelName(e.Level)+"|"+e.Message+"\n"...)
+}
+
+func TestSetFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+
+	l.SetFormatter(levelMsgFormatter{})
+	l.Info("один")
+	l.SetFormatter(nil)
+	l.Head = false
+	l.Color = false
+	l.Info("два")
+
+	if buf.String() != "INFO|один\nдва\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
+
+func TestFormatJSONTime(t *testing.T) {
+	old := time.Local
+	time.Local = time.FixedZone("MSK", 3*60*60)
+	defer func() { time.Local = old }()
+
+	for _, c := range []struct {
+		utc    bool
+		suffix string
+	}{
+		{true, "Z"},
+		{false, "+03:00"},
+	} {
+		var buf bytes.Buffer
+		l := New(&buf, TRACE)
+		l.UTC = c.utc
+		l.SetFormat(FormatJSON)
+		l.Info("время")
+
+		var v struct{ Time string }
+		if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+			t.Fatalf("некорректный JSON %q: %v", buf.String(), err)
+		}
+		if _, err := time.Parse(time.RFC3339Nano, v.Time); err != nil || !strings.HasSuffix(v.Time, c.suffix) {
+			t.Errorf("UTC=%v: неверное время %q (%v)", c.utc, v.Time, err)
+		}
+	}
+}
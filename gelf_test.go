@@ -0,0 +1,55 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGELFFormatter(t *testing.T) {
+	var buf []byte
+	GELFFormatter{Host: "web-1"}.Format(&buf, Entry{
+		Level:   WARN,
+		Time:    time.Unix(1700000000, 123000000),
+		Message: "диск почти заполнен",
+		Fields:  []Field{{"id", 7}, {"free space", 0.5}},
+	})
+
+	var v map[string]interface{}
+	if err := json.Unmarshal(buf, &v); err != nil {
+		t.Fatalf("некорректный JSON %q: %v", buf, err)
+	}
+	want := map[string]interface{}{
+		"version":       "1.1",
+		"host":          "web-1",
+		"short_message": "диск почти заполнен",
+		"timestamp":     1700000000.123,
+		"level":         4.0,
+		"__id":          7.0,
+		"_free_space":   0.5,
+	}
+	for k, w := range want {
+		if v[k] != w {
+			t.Errorf("%s = %v, ожидалось %v", k, v[k], w)
+		}
+	}
+	if len(v) != len(want) {
+		t.Errorf("лишние ключи: %v", v)
+	}
+}
+
+func TestFormatGELF(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.SetFormat(FormatGELF)
+	if _, ok := l.formatter.(GELFFormatter); !ok {
+		t.Fatalf("установлен форматтер %T", l.formatter)
+	}
+	l.SetFormatter(GELFFormatter{Host: "h", NullDelimiter: true})
+	l.Debug("сообщение")
+
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\"level\":7}\x00")) {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
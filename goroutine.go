@@ -0,0 +1,27 @@
+package log
+
+import "runtime"
+
+// Получить номер текущей горутины.
+//
+// Номер разбирается из первой строки runtime.Stack(): "goroutine 123
+// [running]:". Возвращает 0, если разобрать строку не удалось.
+func goroutineID() uint64 {
+	var b [64]byte
+	var s = b[:runtime.Stack(b[:], false)]
+
+	const prefix = "goroutine "
+	if len(s) < len(prefix) || string(s[:len(prefix)]) != prefix {
+		return 0
+	}
+
+	var id uint64
+	for _, c := range s[len(prefix):] {
+		if c < '0' || c > '9' {
+			break
+		}
+		id = id*10 + uint64(c-'0')
+	}
+
+	return id
+}
@@ -0,0 +1,38 @@
+package log
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestHeadGoroutine(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.HeadLevel = false
+	l.HeadGoroutine = true
+
+	var ids = make(chan uint64, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			l.Info("x")
+			ids <- goroutineID()
+		}()
+	}
+	a, b := <-ids, <-ids
+	if a == 0 || a == b {
+		t.Fatalf("неверные номера горутин: %d, %d", a, b)
+	}
+
+	for _, id := range []uint64{a, b} {
+		if !bytes.Contains(buf.Bytes(), []byte("[g"+strconv.FormatUint(id, 10)+"]: x\n")) {
+			t.Errorf("в выводе %q нет горутины %d", buf.String(), id)
+		}
+	}
+	if !regexp.MustCompile(`^(\[g\d+\]: x\n){2}$`).Match(buf.Bytes()) {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
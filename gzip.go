@@ -0,0 +1,166 @@
+package log
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// GzipWriter сжимает журнал в формате gzip на лету.
+//
+// Сжатые данные накапливаются в компрессоре и попадают в цель вывода
+// блоками. Чтобы при аварийном завершении терялась лишь небольшая часть
+// журнала, поток периодически сбрасывается: через заданный интервал после
+// первой несброшенной записи. Метод Flush() сбрасывает поток сразу, его
+// вызывает Logger.Flush(), поэтому после вызова Logger.Flush() все
+// записанные строки можно прочитать из файла. Logger.Close() завершает
+// поток и закрывает цель вывода.
+//
+// Файл, дописываемый несколькими запусками, остаётся корректным: gzip
+// допускает последовательность сжатых потоков, а gzip -d и gzip.Reader
+// читают её целиком.
+//
+// Пример:
+//
+//	f, err := os.OpenFile("app.log.gz", os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+//	if err != nil {
+//		return err
+//	}
+//	l := log.New(log.NewGzipWriter(f, time.Second), log.INFO)
+//	defer l.Close()
+//
+// Безопасен для одновременного использования из нескольких горутин.
+// Создаётся с помощью конструктора: log.NewGzipWriter().
+type GzipWriter struct {
+	mu       sync.Mutex
+	out      io.Writer     // Цель вывода.
+	gz       *gzip.Writer  // Компрессор.
+	interval time.Duration // Интервал сброса потока.
+	timer    *time.Timer   // Таймер сброса по интервалу.
+	dirty    bool          // Есть несброшенные данные.
+	err      error         // Ошибка фонового сброса.
+	closed   bool          // Поток завершён.
+}
+
+// NewGzipWriter создаёт писатель, сжимающий данные в цель вывода out.
+//
+// Параметры:
+//
+// - interval - Наибольшее время, в течение которого записанные данные
+// могут оставаться в компрессоре. Значение <= 0 отключает сброс по
+// таймеру, данные сбрасываются только вызовом Flush() и при заполнении
+// внутреннего буфера компрессора.
+func NewGzipWriter(out io.Writer, interval time.Duration) *GzipWriter {
+	return &GzipWriter{
+		out:      out,
+		gz:       gzip.NewWriter(out),
+		interval: max(interval, 0),
+	}
+}
+
+// Write сжимает данные. Ошибка фонового сброса возвращается при
+// следующей записи или сбросе.
+func (w *GzipWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+
+	n, err := w.gz.Write(p)
+	if w.err != nil {
+		err = w.err
+		w.err = nil
+	}
+	w.dirty = true
+	if w.timer == nil && w.interval > 0 {
+		w.timer = time.AfterFunc(w.interval, w.expire)
+	}
+
+	return n, err
+}
+
+// Flush сбрасывает поток в цель вывода, чтобы все записанные данные можно
+// было распаковать, и сбрасывает буфер цели вывода, если она это
+// поддерживает.
+func (w *GzipWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err = w.err
+	w.err = nil
+	if e := w.flush(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+// Close завершает поток gzip и закрывает цель вывода, если она реализует
+// io.Closer. Стандартные потоки os.Stdout и os.Stderr не закрываются.
+// Повторный вызов ничего не делает.
+func (w *GzipWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+
+	var err = w.err
+	w.err = nil
+	if e := w.gz.Close(); err == nil {
+		err = e
+	}
+	if f, ok := w.out.(flusher); ok {
+		if e := f.Flush(); err == nil {
+			err = e
+		}
+	}
+	if c, ok := w.out.(io.Closer); ok && w.out != io.Writer(os.Stdout) && w.out != io.Writer(os.Stderr) {
+		if e := c.Close(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Сбросить поток. Вызывается под мьютексом.
+func (w *GzipWriter) flush() error {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	if w.closed || !w.dirty {
+		return nil
+	}
+	w.dirty = false
+
+	var err = w.gz.Flush()
+	if f, ok := w.out.(flusher); ok {
+		if e := f.Flush(); err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Сбросить поток по истечении интервала.
+func (w *GzipWriter) expire() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if e := w.flush(); w.err == nil {
+		w.err = e
+	}
+}
@@ -0,0 +1,89 @@
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Распаковать доступную часть потока gzip.
+func gunzip(t *testing.T, data []byte) string {
+	t.Helper()
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Multistream(true)
+	b, err := io.ReadAll(r)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestGzipWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewGzipWriter(&buf, 0)
+	l := NewTestLogger(w)
+	l.Head = false
+
+	l.Info("a")
+	l.Info("b")
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if s := gunzip(t, buf.Bytes()); s != "a\nb\n" {
+		t.Errorf("после Flush прочитано %q", s)
+	}
+
+	l.Info("c")
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if s := gunzip(t, buf.Bytes()); s != "a\nb\nc\n" {
+		t.Errorf("после Close прочитано %q", s)
+	}
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Errorf("запись после Close не вернула ошибку")
+	}
+}
+
+func TestGzipWriterInterval(t *testing.T) {
+	var w countWriter
+	g := NewGzipWriter(&w, 10*time.Millisecond)
+	g.Write([]byte("строка\n"))
+
+	time.Sleep(50 * time.Millisecond)
+	if s := gunzip(t, []byte(strings.Join(w.list(), ""))); s != "строка\n" {
+		t.Errorf("поток не сброшен по таймеру: %q", s)
+	}
+	g.Close()
+}
+
+func TestGzipWriterAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log.gz")
+	for _, s := range []string{"первый\n", "второй\n"} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := NewGzipWriter(f, 0)
+		w.Write([]byte(s))
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := gunzip(t, data); s != "первый\nвторой\n" {
+		t.Errorf("прочитано %q", s)
+	}
+}
@@ -0,0 +1,31 @@
+package log
+
+import "time"
+
+// SetHeaderFunc устанавливает функцию построения заголовка сообщений.
+//
+// Если функция задана, она полностью заменяет встроенный заголовок
+// текстового формата: настройки HeadLevel, HeadDate, HeadTime, HeadCaller
+// и прочие не применяются, а возвращённая строка выводится перед телом
+// сообщения как есть, без раскраски и разделителя. Например:
+//
+//	l.SetHeaderFunc(func(level log.Level, t time.Time) string {
+//		return t.Format(time.Kitchen) + " " + level.String() + " | "
+//	})
+//
+// Время передаётся уже приведённым к Location или UTC. Заголовок выводится
+// только при включенном Head. Функция вызывается под мьютексом логгера,
+// поэтому не должна обращаться к его методам, и может вызываться
+// одновременно из нескольких горутин. Значение nil восстанавливает
+// встроенный заголовок.
+func (l *Logger) SetHeaderFunc(f func(level Level, t time.Time) string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.headerFunc = f
+}
+
+// SetHeaderFunc устанавливает функцию построения заголовка дефолтного
+// логгера. Подробнее смотрите: Logger.SetHeaderFunc().
+func SetHeaderFunc(f func(level Level, t time.Time) string) {
+	std.SetHeaderFunc(f)
+}
@@ -0,0 +1,29 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestSetHeaderFunc(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Color = true
+	l.Location = time.FixedZone("MSK", 3*60*60)
+
+	l.SetHeaderFunc(func(level Level, t time.Time) string {
+		return level.String() + " " + t.Format("MST") + " | "
+	})
+	l.Info("a")
+	l.Clone().Warn("b")
+	l.SetHeaderFunc(nil)
+	l.Color = false
+	l.HeadDate = false
+	l.HeadTime = false
+	l.Info("c")
+
+	if want := "INFO MSK | a\nWARN MSK | b\n[INFO] : c\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
@@ -0,0 +1,102 @@
+package log
+
+import (
+	"regexp"
+	"sort"
+
+	acolor "github.com/VolkovRA/GoAColor"
+)
+
+// Правило подсветки фрагментов текста сообщений.
+type highlight struct {
+	re *regexp.Regexp // Шаблон фрагментов.
+	on string         // Управляющая последовательность цвета.
+}
+
+// SetHighlight подсвечивает фрагменты текста сообщений, соответствующие
+// регулярному выражению pattern, цветом codes.
+//
+// Это ускоряет просмотр журнала в терминале: например, можно выделить
+// номер отслеживаемого заказа или все адреса IP:
+//
+//	l.SetHighlight(`order-4711`, acolor.Bold, acolor.Magenta)
+//	l.SetHighlight(`\d+\.\d+\.\d+\.\d+`, acolor.Cyan)
+//
+// Правила применяются в порядке добавления: если фрагменты разных правил
+// пересекаются, подсвечивается фрагмент более раннего правила. Повторный
+// вызов с тем же шаблоном заменяет цвет правила, а вызов без кодов цвета
+// удаляет правило. Подсветка применяется только к тексту сообщения в
+// текстовом формате при включённой раскраске, см. Logger.Color, и не
+// применяется к тексту в кавычках (QuoteBody). Шаблон компилируется один
+// раз, при ошибке в шаблоне правило не добавляется.
+func (l *Logger) SetHighlight(pattern string, codes ...acolor.Color) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var list = make([]highlight, 0, len(l.highlights)+1)
+	var found bool
+	for _, h := range l.highlights {
+		if h.re.String() != pattern {
+			list = append(list, h)
+			continue
+		}
+		found = true
+		if len(codes) > 0 {
+			list = append(list, highlight{re: re, on: acolor.Apply(codes...)})
+		}
+	}
+	if !found && len(codes) > 0 {
+		list = append(list, highlight{re: re, on: acolor.Apply(codes...)})
+	}
+	l.highlights = list
+
+	return nil
+}
+
+// SetHighlight подсвечивает фрагменты текста сообщений дефолтного логгера.
+// Подробнее смотрите: Logger.SetHighlight().
+func SetHighlight(pattern string, codes ...acolor.Color) error {
+	return std.SetHighlight(pattern, codes...)
+}
+
+// Записать текст сообщения с подсветкой фрагментов.
+// После каждого фрагмента восстанавливается цвет restore.
+func (l *Logger) appendHighlighted(buf *[]byte, msg string, restore string) {
+	type span struct {
+		start, end int
+		on         string
+	}
+
+	var spans []span
+	for _, h := range l.highlights {
+	next:
+		for _, m := range h.re.FindAllStringIndex(msg, -1) {
+			if m[0] == m[1] {
+				continue
+			}
+			for _, s := range spans {
+				if m[0] < s.end && s.start < m[1] {
+					continue next
+				}
+			}
+			spans = append(spans, span{m[0], m[1], h.on})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	var pos int
+	for _, s := range spans {
+		*buf = append(*buf, msg[pos:s.start]...)
+		*buf = append(*buf, s.on...)
+		*buf = append(*buf, msg[s.start:s.end]...)
+		*buf = append(*buf, colorClear...)
+		*buf = append(*buf, restore...)
+		pos = s.end
+	}
+	*buf = append(*buf, msg[pos:]...)
+}
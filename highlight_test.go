@@ -0,0 +1,35 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	acolor "github.com/VolkovRA/GoAColor"
+)
+
+func TestSetHighlight(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.Color = true
+
+	if err := l.SetHighlight(`(`, acolor.Red); err == nil {
+		t.Errorf("неверный шаблон принят")
+	}
+	l.SetHighlight(`order-\d+`, acolor.Magenta)
+	l.SetHighlight(`\d+`, acolor.Cyan)
+
+	l.Info("order-42 из 7")
+	l.Error("код 5")
+	l.SetHighlight(`order-\d+`)
+	l.Color = false
+	l.Info("order-42")
+
+	on, num, clr := acolor.Apply(acolor.Magenta), acolor.Apply(acolor.Cyan), acolor.Clear()
+	want := on + "order-42" + clr + " из " + num + "7" + clr + "\n" +
+		colorError + "код " + num + "5" + clr + colorError + clr + "\n" +
+		"order-42\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
@@ -0,0 +1,56 @@
+package log
+
+// Hook описывает обработчик событий журнала.
+//
+// Хуки позволяют подключить к логгеру интеграции без изменения его кода:
+// счётчики метрик, оповещения об ошибках, отправку сообщений во внешние
+// системы. Подключается с помощью Logger.AddHook().
+type Hook interface {
+	// Levels возвращает уровни важности, на которые реагирует хук.
+	Levels() []Level
+
+	// Fire вызывается для каждого записанного сообщения одного из уровней
+	// Levels(). Параметр msg содержит текст сообщения без заголовка, полей
+	// и раскраски.
+	Fire(level Level, msg string) error
+}
+
+// AddHook подключает хук к логгеру.
+//
+// Хуки вызываются после форматирования сообщения в порядке подключения.
+// Сообщения, отброшенные уровнем важности, заглушкой или ограничением
+// частоты, в хуки не передаются. Ошибка хука не прерывает запись и
+// возвращается вызывающему, если запись в цель вывода прошла успешно.
+//
+// Хук вызывается под мьютексом записи логгера, поэтому не должен писать
+// в этот же логгер и блокироваться надолго. Производные логгеры (With,
+// Clone) получают хуки исходного на момент создания.
+func (l *Logger) AddHook(h Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, h)
+}
+
+// AddHook подключает хук к дефолтному логгеру.
+// Подробнее смотрите: Logger.AddHook().
+func AddHook(h Hook) {
+	std.AddHook(h)
+}
+
+// Вызвать хуки, подписанные на уровень level.
+// Возвращает первую ошибку хуков.
+func (l *Logger) fireHooks(level Level, msg string) error {
+	var err error
+	for _, h := range l.hooks {
+		for _, v := range h.Levels() {
+			if v != level {
+				continue
+			}
+			if e := h.Fire(level, msg); err == nil {
+				err = e
+			}
+			break
+		}
+	}
+	return err
+}
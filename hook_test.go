@@ -0,0 +1,43 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Хук, запоминающий полученные сообщения.
+type testHook struct {
+	levels []Level
+	msgs   []string
+	err    error
+}
+
+func (h *testHook) Levels() []Level { return h.levels }
+
+func (h *testHook) Fire(level Level, msg string) error {
+	h.msgs = append(h.msgs, levelName(level)+" "+msg)
+	return h.err
+}
+
+func TestAddHook(t *testing.T) {
+	l := NewTestLogger(&bytes.Buffer{})
+	l.Color = true
+
+	h := &testHook{levels: []Level{WARN, ERROR}}
+	l.AddHook(h)
+	l.Info("info")
+	l.Warn("warn")
+	l.With("user", 1).Error("error")
+
+	want := []string{"WARN warn", "ERROR error"}
+	if !reflect.DeepEqual(h.msgs, want) {
+		t.Errorf("хук получил %q, ожидалось %q", h.msgs, want)
+	}
+
+	h.err = errors.New("hook failed")
+	if err := l.write(ERROR, "x"); err != h.err {
+		t.Errorf("ошибка хука не возвращена: %v", err)
+	}
+}
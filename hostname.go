@@ -0,0 +1,30 @@
+package log
+
+// SetHostname заменяет имя хоста, выводимое в заголовке при включенном
+// HeadHostname.
+//
+// Пригодится в контейнерах, где автоматически определённое имя - это
+// случайный идентификатор. Пустая строка восстанавливает имя, полученное
+// через os.Hostname().
+func (l *Logger) SetHostname(name string) {
+	if name == "" {
+		name = hostname()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hostname = name
+}
+
+// SetHostname заменяет имя хоста в заголовке дефолтного логгера.
+// Подробнее смотрите: Logger.SetHostname().
+func SetHostname(name string) {
+	std.SetHostname(name)
+}
+
+// Получить имя хоста.
+// Определяется один раз при первом обращении.
+func hostname() string {
+	host, _, _ := processInfo()
+	return host
+}
@@ -0,0 +1,36 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestHeadHostname(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.HeadLevel = false
+	l.HeadHostname = true
+
+	host, _ := os.Hostname()
+	l.Info("a")
+	l.SetHostname("web-1")
+	l.Info("b")
+	l.SetHostname("")
+	l.Info("c")
+
+	if want := host + ": a\nweb-1: b\n" + host + ": c\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+
+	buf.Reset()
+	l.SetHostname("web-2")
+	l.SetFormat(FormatJSON)
+	l.Clone().Info("d")
+	if !strings.Contains(buf.String(), `"msg":"d","host":"web-2"`) {
+		t.Errorf("в JSON нет имени хоста: %q", buf.String())
+	}
+}
@@ -0,0 +1,157 @@
+//go:build linux
+
+package log
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+)
+
+// Путь к сокету собственного протокола journald.
+var journalSocket = "/run/systemd/journal/socket"
+
+// JournaldWriter пишет журнал в systemd-journald по его собственному
+// протоколу, без cgo и libsystemd.
+//
+// Реализует LevelWriter, поэтому уровень важности каждого сообщения
+// логгера передаётся в поле PRIORITY: ERROR - err, WARN - warning, INFO -
+// info, DEBUG и TRACE - debug. Это позволяет фильтровать журнал, например:
+// journalctl -p warning. Поле SYSLOG_IDENTIFIER содержит имя приложения.
+// Время и номер процесса journald добавляет сам, поэтому заголовок
+// логгера обычно отключают:
+//
+//	w, err := log.NewJournaldWriter("myapp")
+//	l := log.New(w, log.INFO)
+//	l.Head = false
+//
+// Сообщения, не помещающиеся в датаграмму, передаются через временный
+// файл, как это делает libsystemd. Доступен только в Linux.
+// Безопасен для одновременного использования из нескольких горутин.
+type JournaldWriter struct {
+	mu     sync.Mutex
+	app    string        // Имя приложения.
+	addr   *net.UnixAddr // Адрес сокета journald.
+	conn   *net.UnixConn // Неподключенный сокет для отправки сообщений.
+	closed bool          // Писатель закрыт.
+}
+
+// NewJournaldWriter подключается к journald.
+// Параметр app задаёт имя приложения в поле SYSLOG_IDENTIFIER, по
+// умолчанию - имя исполняемого файла. Возвращает ошибку, если journald
+// недоступен, например, в системе без systemd.
+func NewJournaldWriter(app string) (*JournaldWriter, error) {
+	if app == "" {
+		app = filepath.Base(os.Args[0])
+	}
+
+	if _, err := os.Stat(journalSocket); err != nil {
+		return nil, err
+	}
+
+	// Подключенный сокет не позволяет передать дескриптор файла
+	// через WriteMsgUnix(), поэтому адрес указывается при каждой записи.
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &JournaldWriter{
+		app:  app,
+		addr: &net.UnixAddr{Name: journalSocket, Net: "unixgram"},
+		conn: conn,
+	}, nil
+}
+
+// Write отправляет сообщение с уровнем важности INFO.
+func (w *JournaldWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(INFO, p)
+}
+
+// WriteLevel отправляет сообщение с уровнем важности level.
+// Завершающий перевод строки в сообщение не включается.
+func (w *JournaldWriter) WriteLevel(level Level, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, net.ErrClosed
+	}
+
+	var msg = w.format(level, p)
+	_, _, err := w.conn.WriteMsgUnix(msg, nil, w.addr)
+	if errors.Is(err, syscall.EMSGSIZE) || errors.Is(err, syscall.ENOBUFS) {
+		err = w.sendFile(msg)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close закрывает соединение. Повторный вызов ничего не делает.
+func (w *JournaldWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	return w.conn.Close()
+}
+
+// Получить сообщение в собственном формате journald: поля KEY=value по
+// одному в строке. Значение с переводами строк записывается в двоичном
+// виде: KEY, перевод строки, длина значения (64 бита, little-endian),
+// значение и перевод строки.
+func (w *JournaldWriter) format(level Level, p []byte) []byte {
+	p = bytes.TrimSuffix(p, []byte{'\n'})
+	p = bytes.TrimSuffix(p, []byte{'\r'})
+
+	var msg = make([]byte, 0, len(p)+64)
+	msg = append(msg, "PRIORITY="...)
+	msg = strconv.AppendInt(msg, int64(syslogSeverity(level)), 10)
+	msg = append(msg, "\nSYSLOG_IDENTIFIER="...)
+	msg = append(msg, w.app...)
+
+	if bytes.IndexByte(p, '\n') < 0 {
+		msg = append(msg, "\nMESSAGE="...)
+	} else {
+		msg = append(msg, "\nMESSAGE\n"...)
+		msg = binary.LittleEndian.AppendUint64(msg, uint64(len(p)))
+	}
+	msg = append(msg, p...)
+	msg = append(msg, '\n')
+
+	return msg
+}
+
+// Отправить большое сообщение через временный файл.
+// Файл удаляется сразу после создания, journald получает только его
+// дескриптор.
+func (w *JournaldWriter) sendFile(msg []byte) error {
+	f, err := os.CreateTemp("/dev/shm", "journal")
+	if err != nil {
+		if f, err = os.CreateTemp("", "journal"); err != nil {
+			return err
+		}
+	}
+	defer f.Close()
+	os.Remove(f.Name())
+
+	if _, err := f.Write(msg); err != nil {
+		return err
+	}
+
+	_, _, err = w.conn.WriteMsgUnix(nil, syscall.UnixRights(int(f.Fd())), w.addr)
+	return err
+}
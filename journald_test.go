@@ -0,0 +1,106 @@
+//go:build linux
+
+package log
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestJournaldWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.sock")
+	srv, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Skip("Unix сокеты недоступны:", err)
+	}
+	defer srv.Close()
+
+	old := journalSocket
+	journalSocket = path
+	defer func() { journalSocket = old }()
+
+	w, err := NewJournaldWriter("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	l := New(w, TRACE)
+	l.Head = false
+	l.Warn("диск заполнен")
+	l.Error("a\nb")
+
+	var want = []string{
+		"PRIORITY=4\nSYSLOG_IDENTIFIER=app\nMESSAGE=диск заполнен\n",
+		"PRIORITY=3\nSYSLOG_IDENTIFIER=app\nMESSAGE\n\x03\x00\x00\x00\x00\x00\x00\x00a\nb\n",
+	}
+	var buf [256]byte
+	for _, v := range want {
+		n, err := srv.Read(buf[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != v {
+			t.Errorf("неверное сообщение:\n%q\nожидалось:\n%q", buf[:n], v)
+		}
+	}
+
+	w.Close()
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Errorf("запись в закрытый писатель не вернула ошибку")
+	}
+}
+
+func TestJournaldWriterLarge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.sock")
+	srv, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Skip("Unix сокеты недоступны:", err)
+	}
+	defer srv.Close()
+
+	old := journalSocket
+	journalSocket = path
+	defer func() { journalSocket = old }()
+
+	w, err := NewJournaldWriter("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	var text = strings.Repeat("x", 4<<20)
+	if _, err := w.WriteLevel(INFO, []byte(text+"\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf, oob [64]byte
+	_, oobn, _, _, err := srv.ReadMsgUnix(buf[:], oob[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+	if err != nil || len(msgs) != 1 {
+		t.Fatalf("дескриптор файла не передан: %v", err)
+	}
+	fds, err := syscall.ParseUnixRights(&msgs[0])
+	if err != nil || len(fds) != 1 {
+		t.Fatalf("дескриптор файла не передан: %v", err)
+	}
+
+	f := os.NewFile(uintptr(fds[0]), "journal")
+	defer f.Close()
+	f.Seek(0, io.SeekStart)
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "PRIORITY=6\nSYSLOG_IDENTIFIER=app\nMESSAGE=" + text + "\n"; string(data) != want {
+		t.Errorf("неверное содержимое файла: %d байт, ожидалось %d", len(data), len(want))
+	}
+}
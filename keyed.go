@@ -0,0 +1,124 @@
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+// Интервал очистки устаревших ключей LogKeyed().
+const keyedSweepEvery = time.Minute
+
+// Состояние одного ключа LogKeyed().
+type keyedState struct {
+	mu         sync.Mutex
+	last       time.Time     // Время последней записи.
+	interval   time.Duration // Интервал, заданный при последней записи.
+	suppressed uint64        // Подавлено сообщений с последней записи.
+	level      Level         // Уровень последнего записанного сообщения.
+	args       []interface{} // Аргументы последнего записанного сообщения.
+}
+
+// LogKeyed записывает сообщение не чаще одного раза за интервал
+// minInterval для каждого ключа key.
+//
+// Первое сообщение с ключом записывается сразу, а последующие в течение
+// minInterval подавляются. Когда интервал истечёт, следующее сообщение с
+// этим ключом будет записано с пометкой о количестве подавленных, например:
+// "соединение потеряно (12 similar messages suppressed)". Общее количество
+// подавленных сообщений возвращает Logger.SuppressedCount().
+//
+// Это общий примитив для ограничения частоты однотипных сообщений, из
+// которого легко получить остальные варианты: при очень большом интервале
+// сообщение пишется один раз, а ключ может включать идентификатор объекта,
+// чтобы ограничивать сообщения о каждом объекте отдельно:
+//
+//	l.LogKeyed(log.WARN, "db:"+host, time.Minute, "нет соединения с ", host)
+//
+// Состояние ключей хранится в логгере и безопасно для одновременного
+// использования. Ключи, интервал которых истёк, периодически удаляются,
+// поэтому число ключей не растёт неограниченно. Если у удаляемого ключа
+// есть подавленные сообщения, перед удалением последнее записанное
+// сообщение повторяется с пометкой об их количестве. Вызов игнорируется, если
+// уровень важности логируемых сообщений не соответствует: level.
+func (l *Logger) LogKeyed(level Level, key string, minInterval time.Duration, v ...interface{}) {
+	if !l.EnabledFast(level) {
+		return
+	}
+
+	var now = time.Now()
+	l.sweepKeyed(now)
+
+	s, _ := l.keyed.LoadOrStore(key, &keyedState{})
+	st := s.(*keyedState)
+
+	st.mu.Lock()
+	if !st.last.IsZero() && now.Sub(st.last) < minInterval {
+		st.suppressed++
+		st.mu.Unlock()
+		l.suppressed.Add(1)
+		return
+	}
+	var n = st.suppressed
+	st.last, st.interval, st.suppressed = now, minInterval, 0
+	st.level, st.args = level, v
+	st.mu.Unlock()
+
+	l.write(level, keyedArgs(v, n)...)
+}
+
+// Получить аргументы сообщения с пометкой о количестве подавленных.
+func keyedArgs(v []interface{}, n uint64) []interface{} {
+	if n == 0 {
+		return v
+	}
+	return append(v[:len(v):len(v)], " (", n, " similar messages suppressed)")
+}
+
+// LogKeyed записывает сообщение в дефолтный логгер не чаще одного раза за
+// интервал для каждого ключа. Подробнее смотрите: Logger.LogKeyed().
+func LogKeyed(level Level, key string, minInterval time.Duration, v ...interface{}) {
+	std.LogKeyed(level, key, minInterval, v...)
+}
+
+// SuppressedCount возвращает количество сообщений, не записанных в журнал
+// из-за ограничения частоты Logger.LogKeyed(), Logger.SetRateLimit() и
+// подавления повторов Logger.SetDedup().
+func (l *Logger) SuppressedCount() uint64 {
+	return l.suppressed.Load()
+}
+
+// Удалить ключи, интервал которых истёк, записав сводку подавленных
+// сообщений. Выполняется не чаще одного раза за keyedSweepEvery.
+func (l *Logger) sweepKeyed(now time.Time) {
+	var last = l.keyedSweep.Load()
+	if now.UnixNano()-last < int64(keyedSweepEvery) || !l.keyedSweep.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	type summary struct {
+		level Level
+		args  []interface{}
+		n     uint64
+	}
+	var pending []summary
+
+	l.keyed.Range(func(k, s interface{}) bool {
+		st := s.(*keyedState)
+		st.mu.Lock()
+		if now.Sub(st.last) >= st.interval {
+			l.keyed.Delete(k)
+			if st.suppressed > 0 {
+				pending = append(pending, summary{st.level, st.args, st.suppressed})
+			}
+			// Вызов, успевший получить удалённое состояние, запишет
+			// сообщение, а не подавит его без учёта.
+			st.last, st.suppressed = time.Time{}, 0
+		}
+		st.mu.Unlock()
+		return true
+	})
+
+	for _, v := range pending {
+		l.write(v.level, keyedArgs(v.args, v.n)...)
+	}
+}
@@ -0,0 +1,64 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestLogKeyed(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	for i := 0; i < 5; i++ {
+		l.LogKeyed(WARN, "a", 50*time.Millisecond, "сообщение a")
+		l.LogKeyed(WARN, "b", time.Hour, "сообщение b")
+	}
+	time.Sleep(60 * time.Millisecond)
+	l.LogKeyed(WARN, "a", 50*time.Millisecond, "сообщение a")
+
+	want := "сообщение a\nсообщение b\nсообщение a (4 similar messages suppressed)\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if n := l.SuppressedCount(); n != 8 {
+		t.Errorf("подавлено %d сообщений, ожидалось 8", n)
+	}
+}
+
+func TestLogKeyedSweep(t *testing.T) {
+	l := NewTestLogger(&bytes.Buffer{})
+	l.LogKeyed(INFO, "старый", time.Millisecond, "x")
+	l.LogKeyed(INFO, "новый", time.Hour, "x")
+
+	l.sweepKeyed(time.Now().Add(2 * keyedSweepEvery))
+
+	if _, ok := l.keyed.Load("старый"); ok {
+		t.Errorf("устаревший ключ не удалён")
+	}
+	if _, ok := l.keyed.Load("новый"); !ok {
+		t.Errorf("действующий ключ удалён")
+	}
+}
+
+func TestLogKeyedSweepSuppressed(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.LogKeyed(WARN, "a", time.Millisecond, "сообщение a")
+	l.LogKeyed(WARN, "a", time.Hour, "сообщение a")
+	l.LogKeyed(WARN, "a", time.Hour, "сообщение a")
+	l.LogKeyed(INFO, "b", time.Millisecond, "сообщение b")
+
+	l.sweepKeyed(time.Now().Add(2 * keyedSweepEvery))
+
+	want := "сообщение a\nсообщение b\nсообщение a (2 similar messages suppressed)\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if _, ok := l.keyed.Load("a"); ok {
+		t.Errorf("устаревший ключ не удалён")
+	}
+}
@@ -0,0 +1,30 @@
+package log
+
+// SetLevelLabel заменяет название уровня level в маркере заголовка.
+//
+// Позволяет локализовать журнал или использовать собственные краткие
+// обозначения, например:
+//
+//	l.SetLevelLabel(log.WARN, "ВНИМАНИЕ") // [ВНИМАНИЕ] текст
+//	l.SetLevelLabel(log.ERROR, "E")       // [E] текст
+//
+// Маркеры всех уровней по-прежнему выравниваются по самому длинному, см.
+// Logger.LevelWidth. Настройки LevelCompact и LevelLowercase к заданному
+// названию не применяются, цвет маркера остаётся цветом уровня. Пустая
+// строка возвращает стандартное название. Уровни вне диапазона
+// TRACE - ERROR игнорируются.
+func (l *Logger) SetLevelLabel(level Level, label string) {
+	if level < TRACE || level > ERROR {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.labels[level] = label
+}
+
+// SetLevelLabel заменяет название уровня в маркере заголовка дефолтного
+// логгера. Подробнее смотрите: Logger.SetLevelLabel().
+func SetLevelLabel(level Level, label string) {
+	std.SetLevelLabel(level, label)
+}
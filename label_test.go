@@ -0,0 +1,30 @@
+package log
+
+import (
+	"io"
+	"testing"
+)
+
+func TestSetLevelLabel(t *testing.T) {
+	l := New(io.Discard, TRACE)
+	l.SetLevelLabel(WARN, "ВНИМАНИЕ")
+	l.SetLevelLabel(ERROR, "E")
+	l.SetLevelLabel(Level(9), "X")
+
+	want := [...]string{"[TRACE]    ", "[DEBUG]    ", "[INFO]     ", "[ВНИМАНИЕ] ", "[E]        "}
+	for v := TRACE; v <= ERROR; v++ {
+		if got := levelMarker(l, v); got != want[v] {
+			t.Errorf("%v: маркер %q, ожидалось %q", v, got, want[v])
+		}
+	}
+
+	if got := levelMarker(l.Clone(), ERROR); got != want[ERROR] {
+		t.Errorf("копия: маркер %q, ожидалось %q", got, want[ERROR])
+	}
+
+	l.SetLevelLabel(WARN, "")
+	l.SetLevelLabel(ERROR, "")
+	if got := levelMarker(l, WARN); got != "[WARN]  " {
+		t.Errorf("после сброса маркер %q, ожидалось %q", got, "[WARN]  ")
+	}
+}
@@ -0,0 +1,91 @@
+package log
+
+// ErrorFunc выводит сообщение об ошибке, текст которого возвращает f.
+// Функция f вызывается только если уровень ERROR активен, поэтому
+// дорогое составление текста не выполняется впустую.
+func (l *Logger) ErrorFunc(f func() string) {
+	if !l.EnabledFast(ERROR) {
+		return
+	}
+
+	l.output(ERROR, f())
+}
+
+// WarnFunc выводит предупреждение, текст которого возвращает f.
+// Функция f вызывается только если уровень WARN активен.
+func (l *Logger) WarnFunc(f func() string) {
+	if !l.EnabledFast(WARN) {
+		return
+	}
+
+	l.output(WARN, f())
+}
+
+// InfoFunc выводит информационное сообщение, текст которого возвращает f.
+// Функция f вызывается только если уровень INFO активен.
+func (l *Logger) InfoFunc(f func() string) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.output(INFO, f())
+}
+
+// DebugFunc выводит отладочное сообщение, текст которого возвращает f.
+// Функция f вызывается только если уровень DEBUG активен. Это заменяет
+// проверку вида:
+//
+//	if l.IsDebug() {
+//		l.Debug(dump(state))
+//	}
+//
+// на вызов:
+//
+//	l.DebugFunc(func() string { return dump(state) })
+func (l *Logger) DebugFunc(f func() string) {
+	if !l.EnabledFast(DEBUG) {
+		return
+	}
+
+	l.output(DEBUG, f())
+}
+
+// TraceFunc выводит сообщение трассировки, текст которого возвращает f.
+// Функция f вызывается только если уровень TRACE активен.
+func (l *Logger) TraceFunc(f func() string) {
+	if !l.EnabledFast(TRACE) {
+		return
+	}
+
+	l.output(TRACE, f())
+}
+
+// ErrorFunc выводит сообщение об ошибке, текст которого возвращает f.
+// Подробнее смотрите: Logger.ErrorFunc().
+func ErrorFunc(f func() string) {
+	std.ErrorFunc(f)
+}
+
+// WarnFunc выводит предупреждение, текст которого возвращает f.
+// Подробнее смотрите: Logger.WarnFunc().
+func WarnFunc(f func() string) {
+	std.WarnFunc(f)
+}
+
+// InfoFunc выводит информационное сообщение, текст которого возвращает f.
+// Подробнее смотрите: Logger.InfoFunc().
+func InfoFunc(f func() string) {
+	std.InfoFunc(f)
+}
+
+// DebugFunc выводит отладочное сообщение, текст которого возвращает f.
+// Подробнее смотрите: Logger.DebugFunc().
+func DebugFunc(f func() string) {
+	std.DebugFunc(f)
+}
+
+// TraceFunc выводит сообщение трассировки, текст которого возвращает f.
+// Подробнее смотрите: Logger.TraceFunc().
+func TraceFunc(f func() string) {
+	std.TraceFunc(f)
+}
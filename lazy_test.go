@@ -0,0 +1,34 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLevelFunc(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(INFO)
+
+	var calls int
+	text := func(s string) func() string {
+		return func() string {
+			calls++
+			return s
+		}
+	}
+
+	l.TraceFunc(text("trace"))
+	l.DebugFunc(text("debug"))
+	l.InfoFunc(text("info"))
+	l.WarnFunc(text("warn"))
+	l.ErrorFunc(text("error"))
+
+	if want := "info\nwarn\nerror\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if calls != 3 {
+		t.Errorf("функция вызвана %d раз, ожидалось 3", calls)
+	}
+}
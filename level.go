@@ -0,0 +1,103 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String возвращает название уровня: TRACE, DEBUG, INFO, WARN или ERROR.
+// Для значений вне диапазона возвращает текст вида: Level(7).
+func (l Level) String() string {
+	if l < TRACE || l > ERROR {
+		return "Level(" + strconv.Itoa(int(l)) + ")"
+	}
+	return levelName(l)
+}
+
+// MarshalText возвращает название уровня. Реализует encoding.TextMarshaler,
+// поэтому уровень записывается в JSON, YAML и другие форматы строкой.
+func (l Level) MarshalText() ([]byte, error) {
+	if l < TRACE || l > ERROR {
+		return nil, fmt.Errorf("log: invalid level %d", int32(l))
+	}
+	return []byte(levelName(l)), nil
+}
+
+// UnmarshalText устанавливает уровень по его названию без учёта регистра:
+// "trace", "debug", "info", "warn" или "error". Реализует
+// encoding.TextUnmarshaler для чтения уровня из файлов конфигурации.
+// Допустимые значения те же, что и у log.ParseLevel().
+func (l *Level) UnmarshalText(text []byte) error {
+	v, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
+
+// ParseLevel возвращает уровень по его названию.
+//
+// Название сравнивается без учёта регистра и окружающих пробелов: "trace",
+// "debug", "info", "warn" или "error". Также допускается числовое значение
+// уровня от "0" (TRACE) до "4" (ERROR). Для прочих значений возвращает
+// ошибку. Удобно для чтения уровня из переменной окружения:
+//
+//	level, err := log.ParseLevel(os.Getenv("LOG_LEVEL"))
+//	if err == nil {
+//		log.Default().SetLevel(level)
+//	}
+func ParseLevel(s string) (Level, error) {
+	s = strings.TrimSpace(s)
+	for v := TRACE; v <= ERROR; v++ {
+		if strings.EqualFold(s, levelName(v)) {
+			return v, nil
+		}
+	}
+
+	if n, err := strconv.Atoi(s); err == nil && n >= int(TRACE) && n <= int(ERROR) {
+		return Level(n), nil
+	}
+
+	return TRACE, fmt.Errorf("log: unknown level %q, expected one of: trace, debug, info, warn, error", s)
+}
+
+// SetLevelString устанавливает уровень важности логируемых сообщений по
+// его названию. Допустимые значения те же, что и у log.ParseLevel(). При
+// ошибке уровень не меняется. Удобно для изменения подробности журнала
+// работающего сервиса, например, в обработчике HTTP:
+//
+//	http.HandleFunc("/debug/loglevel", func(w http.ResponseWriter, r *http.Request) {
+//		if err := log.SetLevelString(r.FormValue("level")); err != nil {
+//			http.Error(w, err.Error(), http.StatusBadRequest)
+//			return
+//		}
+//		fmt.Fprintln(w, log.LevelString())
+//	})
+func (l *Logger) SetLevelString(s string) error {
+	level, err := ParseLevel(s)
+	if err != nil {
+		return err
+	}
+
+	l.SetLevel(level)
+	return nil
+}
+
+// LevelString возвращает название текущего уровня важности логируемых
+// сообщений: TRACE, DEBUG, INFO, WARN или ERROR.
+func (l *Logger) LevelString() string {
+	return l.Level().String()
+}
+
+// SetLevelString устанавливает уровень дефолтного логгера по его
+// названию. Подробнее смотрите: Logger.SetLevelString().
+func SetLevelString(s string) error {
+	return std.SetLevelString(s)
+}
+
+// LevelString возвращает название текущего уровня дефолтного логгера.
+func LevelString() string {
+	return std.LevelString()
+}
@@ -0,0 +1,75 @@
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+)
+
+func TestLevelString(t *testing.T) {
+	for v, want := range map[Level]string{
+		TRACE: "TRACE",
+		DEBUG: "DEBUG",
+		INFO:  "INFO",
+		WARN:  "WARN",
+		ERROR: "ERROR",
+		7:     "Level(7)",
+		-1:    "Level(-1)",
+	} {
+		if got := v.String(); got != want {
+			t.Errorf("Level(%d).String() = %q, ожидалось %q", int32(v), got, want)
+		}
+	}
+}
+
+func TestLevelText(t *testing.T) {
+	var cfg struct{ Level Level }
+
+	b, err := json.Marshal(struct{ Level Level }{WARN})
+	if err != nil || string(b) != `{"Level":"WARN"}` {
+		t.Errorf("неверная сериализация: %s %v", b, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Level":"Debug"}`), &cfg); err != nil || cfg.Level != DEBUG {
+		t.Errorf("неверное чтение: %v %v", cfg.Level, err)
+	}
+	if err := json.Unmarshal([]byte(`{"Level":"verbose"}`), &cfg); err == nil {
+		t.Errorf("ожидалась ошибка для неизвестного уровня")
+	}
+	if _, err := Level(9).MarshalText(); err == nil {
+		t.Errorf("ожидалась ошибка для уровня вне диапазона")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for s, want := range map[string]Level{
+		"trace":   TRACE,
+		"DEBUG":   DEBUG,
+		" Info\n": INFO,
+		"warn":    WARN,
+		"Error":   ERROR,
+		"0":       TRACE,
+		"4":       ERROR,
+	} {
+		if got, err := ParseLevel(s); err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v, ожидалось %v", s, got, err, want)
+		}
+	}
+
+	for _, s := range []string{"", "warning", "5", "-1", "1.0"} {
+		if _, err := ParseLevel(s); err == nil {
+			t.Errorf("ParseLevel(%q): ожидалась ошибка", s)
+		}
+	}
+}
+
+func TestSetLevelString(t *testing.T) {
+	l := New(io.Discard, INFO)
+
+	if err := l.SetLevelString(" Debug "); err != nil || l.Level() != DEBUG {
+		t.Errorf("уровень не установлен: %v, %v", l.Level(), err)
+	}
+	if err := l.SetLevelString("verbose"); err == nil || l.LevelString() != "DEBUG" {
+		t.Errorf("неверный уровень изменил настройку: %v, %v", l.LevelString(), err)
+	}
+}
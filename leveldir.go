@@ -0,0 +1,71 @@
+package log
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetLevelOutput направляет сообщения уровня level в цель вывода w вместо
+// основной цели логгера.
+//
+// Например, чтобы предупреждения и ошибки писались в stderr, а остальные
+// сообщения в stdout:
+//
+//	l := log.New(os.Stdout, log.TRACE)
+//	l.SetLevelOutput(log.WARN, os.Stderr)
+//	l.SetLevelOutput(log.ERROR, os.Stderr)
+//
+// Значение nil возвращает уровень в основную цель вывода. Вызов SetOutput
+// сбрасывает направление всех уровней. Уровни вне диапазона TRACE - ERROR
+// игнорируются.
+func (l *Logger) SetLevelOutput(level Level, w io.Writer) {
+	if level < TRACE || level > ERROR {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levelOut[level] = w
+}
+
+// SetLevelDir направляет сообщения каждого уровня в отдельный файл
+// в каталоге dir: trace.log, debug.log, info.log, warn.log, error.log.
+//
+// Каждый файл ротируется по размеру maxBytes с хранением maxFiles
+// резервных копий, см. log.NewRotatingFile(). Каталог создаётся при
+// необходимости. Файлы, открытые предыдущим вызовом SetLevelDir,
+// закрываются. Основная цель вывода логгера при этом больше не получает
+// сообщений, пока не будет вызван SetOutput.
+func (l *Logger) SetLevelDir(dir string, maxBytes, maxFiles int) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var files [ERROR + 1]*RotatingFile
+	for level := range files {
+		f, err := NewRotatingFile(filepath.Join(dir, strings.ToLower(levelName(Level(level)))+".log"), int64(maxBytes), maxFiles)
+		if err != nil {
+			for _, v := range files[:level] {
+				v.Close()
+			}
+			return err
+		}
+		files[level] = f
+	}
+
+	l.mu.Lock()
+	old := l.levelFiles
+	l.levelFiles = files[:]
+	for level, f := range files {
+		l.levelOut[level] = f
+	}
+	l.mu.Unlock()
+
+	for _, f := range old {
+		f.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetLevelDir(t *testing.T) {
+	dir := t.TempDir()
+	l := New(os.Stderr, TRACE)
+	l.Head = false
+	l.Color = false
+	if err := l.SetLevelDir(dir, 1<<20, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	l.Trace("t")
+	l.Debug("d")
+	l.Info("i")
+	l.Warn("w")
+	l.write(ERROR, "e")
+	l.SetOutput(os.Stderr)
+
+	for _, name := range []string{"trace", "debug", "info", "warn", "error"} {
+		b, err := os.ReadFile(filepath.Join(dir, name+".log"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != name[:1]+"\n" {
+			t.Errorf("%s.log: %q", name, b)
+		}
+	}
+}
+
+func TestSetLevelOutput(t *testing.T) {
+	var out, errs bytes.Buffer
+	l := NewTestLogger(&out)
+	l.Head = false
+	l.SetLevelOutput(WARN, &errs)
+	l.SetLevelOutput(ERROR, &errs)
+
+	l.Info("инфо")
+	l.Warn("предупреждение")
+	l.Error("ошибка")
+	l.SetLevelOutput(WARN, nil)
+	l.Warn("снова")
+
+	if out.String() != "инфо\nснова\n" || errs.String() != "предупреждение\nошибка\n" {
+		t.Errorf("неверное направление: %q %q", out.String(), errs.String())
+	}
+}
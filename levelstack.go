@@ -0,0 +1,71 @@
+package log
+
+// PushLevel временно устанавливает уровень важности логируемых сообщений,
+// запоминая прежний. Прежний уровень восстанавливается вызовом PopLevel():
+//
+//	l.PushLevel(log.INFO) // Без отладки во время шумной операции.
+//	defer l.PopLevel()
+//
+// Уровень меняется для всего логгера, а не для текущей горутины, поэтому
+// сообщения других горутин на это время тоже отбрасываются или
+// пропускаются согласно новому уровню.
+func (l *Logger) PushLevel(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levelStack = append(l.levelStack, l.level)
+	l.level = level
+	l.enabled.Store(levelMask(level))
+}
+
+// PopLevel восстанавливает уровень, действовавший до последнего вызова
+// PushLevel(). Если сохранённых уровней нет, ничего не делает.
+func (l *Logger) PopLevel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var n = len(l.levelStack)
+	if n == 0 {
+		return
+	}
+
+	l.level = l.levelStack[n-1]
+	l.levelStack = l.levelStack[:n-1]
+	l.enabled.Store(levelMask(l.level))
+}
+
+// WithLevel временно устанавливает уровень важности логируемых сообщений
+// и возвращает функцию, восстанавливающую прежний уровень:
+//
+//	defer l.WithLevel(log.WARN)()
+//
+// Как и PushLevel(), меняет уровень для всего логгера.
+func (l *Logger) WithLevel(level Level) (restore func()) {
+	l.mu.Lock()
+	var prev = l.level
+	l.level = level
+	l.enabled.Store(levelMask(level))
+	l.mu.Unlock()
+
+	return func() {
+		l.SetLevel(prev)
+	}
+}
+
+// PushLevel временно устанавливает уровень дефолтного логгера.
+// Подробнее смотрите: Logger.PushLevel().
+func PushLevel(level Level) {
+	std.PushLevel(level)
+}
+
+// PopLevel восстанавливает уровень дефолтного логгера, действовавший до
+// последнего вызова PushLevel().
+func PopLevel() {
+	std.PopLevel()
+}
+
+// WithLevel временно устанавливает уровень дефолтного логгера и
+// возвращает функцию его восстановления. Подробнее смотрите:
+// Logger.WithLevel().
+func WithLevel(level Level) (restore func()) {
+	return std.WithLevel(level)
+}
@@ -0,0 +1,49 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPushLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(DEBUG)
+
+	l.PushLevel(WARN)
+	l.PushLevel(ERROR)
+	l.Warn("a")
+	l.PopLevel()
+	l.Warn("b")
+	l.Debug("c")
+	l.PopLevel()
+	l.PopLevel()
+	l.Debug("d")
+
+	if want := "b\nd\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if l.Level() != DEBUG {
+		t.Errorf("уровень %v, ожидался DEBUG", l.Level())
+	}
+}
+
+func TestWithLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	func() {
+		defer l.WithLevel(ERROR)()
+		l.Info("a")
+		if !l.IsError() || l.IsWarn() {
+			t.Errorf("уровень не изменён")
+		}
+	}()
+	l.Info("b")
+
+	if want := "b\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
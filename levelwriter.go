@@ -0,0 +1,34 @@
+package log
+
+import "io"
+
+// LevelWriter описывает цель вывода, которой нужен уровень важности
+// каждого сообщения.
+//
+// Интерфейс io.Writer не передаёт уровень сообщения, а он нужен многим
+// интеграциям: отправке в syslog (см.: SyslogWriter), journald
+// (JournaldWriter) и журнал событий Windows (WindowsEventWriter),
+// раскладке по файлам, раскраске на стороне получателя. Если цель вывода
+// логгера реализует LevelWriter, логгер вызывает WriteLevel вместо Write
+// с уровнем записываемого сообщения. Это относится к основной цели вывода, целям
+// отдельных уровней (SetLevelOutput) и дополнительным целям (AddOutput).
+// AsyncWriter сохраняет уровень и передаёт его своей цели вывода.
+//
+// Параметр p содержит сообщение, полностью отформатированное логгером,
+// включая завершающий перевод строки. Как и для io.Writer, реализация не
+// должна изменять или сохранять p после возврата. Write остаётся
+// обязательным, чтобы цель вывода можно было использовать и как обычный
+// io.Writer.
+type LevelWriter interface {
+	io.Writer
+	WriteLevel(level Level, p []byte) (int, error)
+}
+
+// Записать сообщение уровня level в w.
+// Если w реализует LevelWriter, вызывается WriteLevel, иначе - Write.
+func writeLevel(w io.Writer, level Level, p []byte) (int, error) {
+	if lw, ok := w.(LevelWriter); ok {
+		return lw.WriteLevel(level, p)
+	}
+	return w.Write(p)
+}
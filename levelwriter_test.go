@@ -0,0 +1,43 @@
+package log
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Цель вывода, запоминающая уровни сообщений.
+type levelRecorder struct {
+	mu     sync.Mutex
+	levels []Level
+}
+
+func (w *levelRecorder) Write(p []byte) (int, error) {
+	return w.WriteLevel(-1, p)
+}
+
+func (w *levelRecorder) WriteLevel(level Level, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.levels = append(w.levels, level)
+	return len(p), nil
+}
+
+func TestLevelWriter(t *testing.T) {
+	var main, extra, async levelRecorder
+	a := NewAsyncWriter(&async, 4)
+
+	l := NewTestLogger(&main)
+	l.AddOutput(&extra)
+	l.AddOutput(a)
+	l.Warn("w")
+	l.Debug("d")
+	a.Close()
+
+	want := []Level{WARN, DEBUG}
+	for name, w := range map[string]*levelRecorder{"основная": &main, "дополнительная": &extra, "асинхронная": &async} {
+		if !reflect.DeepEqual(w.levels, want) {
+			t.Errorf("%s цель получила уровни %v, ожидалось %v", name, w.levels, want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package log
+
+import "time"
+
+// SetLocation устанавливает часовой пояс времени сообщений.
+// Значение nil возвращает выбор между UTC и местным временем по флагу UTC.
+// Подробнее смотрите: Logger.Location.
+func (l *Logger) SetLocation(loc *time.Location) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.Location = loc
+}
@@ -1,492 +1,1544 @@
-// Package log расширяет стандартный go логгер для вывода отладочной
-// информации о ходе работы приложения, разделяя его на несколько
-// уровней важности.
-package log
-
-import (
-	"fmt"
-	"io"
-	"os"
-	"sync"
-	"time"
-
-	acolor "github.com/VolkovRA/GoAColor"
-)
-
-// Level описывает уровень важности логируемых сообщений.
-//
-// Необходим для разделения сообщений журнала по уровню важности.
-// Все доступные значения и их описание для применения перечислены в
-// соответствующих константах.
-//
-// Возможные значения:
-//
-// - TRACE - Журналы, содержащие наиболее подробные сообщения.
-// Эти сообщения могут содержать конфиденциальные данные приложения.
-// Эти сообщения по умолчанию отключены, и их никогда не следует включать
-// в рабочей среде.
-//
-// - DEBUG - Журналы, используемые для интерактивного исследования во
-// время разработки. Эти журналы в основном содержат сведения, полезные
-// при отладки и не представляющие ценности в долгосрочной перспективе.
-//
-// - INFO - Журналы, отслеживающие общий поток работы приложения.
-// Эти журналы должны быть полезны в долгосрочной перспективе.
-//
-// - WARN - Журналы, которые показывают ненормальное или неожиданное
-// событие в потоке приложения, но не вызывают прекращение выполнения
-// приложения каким-либо образом.
-//
-// - ERROR - Журналы, описывающие неустранимый сбой приложения или системы
-// либо неустранимый сбой, который требует немедленного внимания.
-type Level int32
-
-// Уровни важности логируемых сообщений.
-// Тут перечислены все доступные уровни важности и их описание для применения.
-const (
-
-	// TRACE - Журналы, содержащие наиболее подробные сообщения.
-	// Эти сообщения могут содержать конфиденциальные данные приложения.
-	// Эти сообщения по умолчанию отключены, и их никогда не следует включать
-	// в рабочей среде.
-	TRACE Level = iota
-
-	// DEBUG - Журналы, используемые для интерактивного исследования во
-	// время разработки. Эти журналы в основном содержат сведения, полезные
-	// при отладки и не представляющие ценности в долгосрочной перспективе.
-	// Используется по умолчанию.
-	DEBUG
-
-	// INFO - Журналы, отслеживающие общий поток работы приложения.
-	// Эти журналы должны быть полезны в долгосрочной перспективе.
-	INFO
-
-	// WARN - Журналы, которые показывают ненормальное или неожиданное
-	// событие в потоке приложения, но не вызывают прекращение выполнения
-	// приложения каким-либо образом.
-	WARN
-
-	// ERROR - Журналы, описывающие неустранимый сбой приложения или системы
-	// либо неустранимый сбой, который требует немедленного внимания.
-	ERROR
-)
-
-// Дефолтный логгер.
-var std = New(os.Stderr, DEBUG)
-
-// Logger описывает один экземпляр логгера.
-//
-// По умолчанию используется дефолтный экземпляр логгера, ссылку на который
-// Вы можете получить с помощью: log.Default(). Он нацелен на стандартный поток
-// вывода сообщений об ошибках. Вы также можете создать собственный экземпляр
-// логгера и нацелить его на произвольный поток вывода с помощью конструктора:
-// log.New()
-type Logger struct {
-
-	// Цветной текст.
-	//
-	// Если задано true, к тексту будет применяться раскраска с помощью
-	// управляющих ANSI символов.
-	//
-	// По умолчанию: true
-	Color bool
-
-	// Время в UTC.
-	//
-	// Если true, логгер будет использовать нулевой часовой пояс, установленный
-	// в локальной системе.
-	//
-	// По умолчанию: true.
-	UTC bool
-
-	// Отображение заголовка. (Целиком)
-	//
-	// Если true, логгер добавляет в каждое сообщение заголовок с системной
-	// информацией: время, уровень важности и т.п.
-	//
-	// По умолчанию: true.
-	Head bool
-
-	// Отображение уровня важности в заголовке.
-	//
-	// Если true, в заголовке каждого сообщения будет присутствовать маркер
-	// уровня важности данного сообщения: [LEVEL].
-	//
-	// По умолчанию: true.
-	HeadLevel bool
-
-	// Отображение даты в заголовке.
-	//
-	// Если true, в заголовке каждого сообщения будет присутствовать дата: DD.MM.YYYY.
-	//
-	// По умолчанию: true.
-	HeadDate bool
-
-	// Отображение времени в заголовке.
-	//
-	// Если true, в заголовке каждого сообщения будет присутствовать время: HH:MM:SS.
-	//
-	// По умолчанию: true.
-	HeadTime bool
-
-	// Отображение микросекунд в заголовке. (Работает только при включенном HeadTime)
-	//
-	// Если true, в заголовке каждого сообщения будут присутствовать микросекунды: HH:MM:SS.000000
-	//
-	// По умолчанию: false.
-	HeadMC bool
-
-	mu    sync.Mutex // Атомарная запись.
-	out   io.Writer  // Назначение для вывода сообщений.
-	level Level      // Уровень логируемых сообщений.
-	buf   []byte     // Буфер для сложения текста при записи.
-}
-
-// New создаёт новый логгер.
-// Вы можете указать цель назначения всех сообщений журнала.
-func New(out io.Writer, level Level) *Logger {
-	return &Logger{
-		out:       out,
-		level:     level,
-		Color:     true,
-		UTC:       true,
-		Head:      true,
-		HeadLevel: true,
-		HeadDate:  true,
-		HeadTime:  true,
-		HeadMC:    false,
-	}
-}
-
-// Default дефолтный логгер, используемый по умолчанию.
-// Вы можете создать собственный логгер, используя вызов: log.New().
-func Default() *Logger {
-	return std
-}
-
-// Записать заголовки сообщения.
-func (l *Logger) writeHeader(buf *[]byte, level Level) {
-
-	// Метка уровня:
-	if l.HeadLevel {
-		*buf = append(*buf, l.getHeaderLevel(level)...)
-	}
-
-	// Цвет заголовка:
-	if l.Color {
-		if level == ERROR {
-			*buf = append(*buf, acolor.Apply(acolor.Red)...)
-		} else {
-			*buf = append(*buf, acolor.Apply(acolor.BlackHi)...)
-		}
-	}
-
-	// Заголовки:
-	if l.HeadDate || l.HeadTime {
-		var now = time.Now()
-		if l.UTC {
-			now = now.UTC()
-		}
-		if l.HeadDate {
-			year, month, day := now.Date()
-
-			itoa(buf, day, 2)
-			*buf = append(*buf, '.')
-			itoa(buf, int(month), 2)
-			*buf = append(*buf, '.')
-			itoa(buf, year, 4)
-			*buf = append(*buf, ' ')
-		}
-		if l.HeadTime {
-			hour, min, sec := now.Clock()
-			itoa(buf, hour, 2)
-			*buf = append(*buf, ':')
-			itoa(buf, min, 2)
-			*buf = append(*buf, ':')
-			itoa(buf, sec, 2)
-
-			if l.HeadMC {
-				*buf = append(*buf, '.')
-				itoa(buf, now.Nanosecond()/1000, 6)
-			}
-
-			*buf = append(*buf, ' ')
-		}
-	}
-
-	// Конец заголовка:
-	var length = len(*buf)
-	if length == 0 {
-		return
-	}
-
-	*buf = (*buf)[0 : length-1]
-
-	if l.Color {
-		*buf = append(*buf, (": " + acolor.Clear())...)
-	} else {
-		*buf = append(*buf, ": "...)
-	}
-}
-
-// Получить метку уровня логирования.
-func (l *Logger) getHeaderLevel(level Level) string {
-	if l.Color {
-		switch level {
-		case INFO:
-			return acolor.Apply(acolor.Bold, acolor.Green) + "[INFO]  " + acolor.Clear()
-		case WARN:
-			return acolor.Apply(acolor.Bold, acolor.Yellow) + "[WARN]  " + acolor.Clear()
-		case TRACE:
-			return acolor.Apply(acolor.Bold, acolor.White) + "[TRACE] " + acolor.Clear()
-		case DEBUG:
-			return acolor.Apply(acolor.Bold, acolor.Cyan) + "[DEBUG] " + acolor.Clear()
-		default:
-			return acolor.Apply(acolor.Bold, acolor.Red) + "[ERROR] " + acolor.Clear()
-		}
-	} else {
-		switch level {
-		case INFO:
-			return "[INFO]  "
-		case WARN:
-			return "[WARN]  "
-		case TRACE:
-			return "[TRACE] "
-		case DEBUG:
-			return "[DEBUG] "
-		default:
-			return "[ERROR] "
-		}
-	}
-}
-
-// Запись инта в строку с фиксированной длиной.
-func itoa(buf *[]byte, i int, wid int) {
-	var b [20]byte
-	bp := len(b) - 1
-	for i >= 10 || wid > 1 {
-		wid--
-		q := i / 10
-		b[bp] = byte('0' + i - q*10)
-		bp--
-		i = q
-	}
-	b[bp] = byte('0' + i)
-	*buf = append(*buf, b[bp:]...)
-}
-
-// Записать сообщение в журнал.
-func (l *Logger) write(level Level, v ...interface{}) error {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	// Шапка:
-	l.buf = l.buf[:0]
-	if l.Head {
-		l.writeHeader(&l.buf, level)
-	}
-
-	// Тело:
-	if l.Color && level == ERROR {
-		l.buf = append(l.buf, (acolor.Apply(acolor.Red) + fmt.Sprint(v...) + acolor.Clear() + "\n")...)
-	} else {
-		l.buf = append(l.buf, (fmt.Sprint(v...) + "\n")...)
-	}
-
-	// Вывод:
-	_, err := l.out.Write(l.buf)
-
-	return err
-}
-
-// Level указывает текущий уровень важности логируемых сообщений.
-//
-// Если сообщение не соответствует уровню важности, оно не попадает в журнал.
-//
-// По умолчанию: LevelTrace. (В журнал попадают все сообщения)
-func (l *Logger) Level() Level {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	return l.level
-}
-
-// SetLevel устанавливает уровень важности логируемых сообщений.
-// Доступные значения Level смотрите в константах пакета.
-func (l *Logger) SetLevel(level Level) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	l.level = level
-}
-
-// Output цель вывода сообщений лога.
-// По умолчанию: os.Stderr.
-func (l *Logger) Output() io.Writer {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	return l.out
-}
-
-// SetOutput устанавливает цель вывода сообщений журнала.
-func (l *Logger) SetOutput(w io.Writer) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	l.out = w
-}
-
-// IsLevel проверяет актуальность указанного уровня логирования.
-//
-// Это полезно, если вам нужно проверить, выводится для в данный
-// момент указанный уровень логируемых сообщений. Например, перед
-// выполнение дорогой операции для создания сообщения для лога.
-//
-// Возвращает true, если указанный уровень логирования актуален.
-func (l *Logger) IsLevel(level Level) bool {
-	return level >= l.level
-}
-
-// IsError проверяет актуальность уровня логирования: ERROR.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func (l *Logger) IsError() bool {
-	return l.IsLevel(ERROR)
-}
-
-// IsWarn проверяет актуальность уровня логирования: WARN.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func (l *Logger) IsWarn() bool {
-	return l.IsLevel(WARN)
-}
-
-// IsInfo проверяет актуальность уровня логирования: INFO.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func (l *Logger) IsInfo() bool {
-	return l.IsLevel(INFO)
-}
-
-// IsDebug проверяет актуальность уровня логирования: DEBUG.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func (l *Logger) IsDebug() bool {
-	return l.IsLevel(DEBUG)
-}
-
-// IsTrace проверяет актуальность уровня логирования: TRACE.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func (l *Logger) IsTrace() bool {
-	return l.IsLevel(TRACE)
-}
-
-// Error выводит сообщение об ошибке и завершает работу приложения.
-// Пишет сообщение о фатальной ошибке и вызывает: os.Exit(1).
-func (l *Logger) Error(v ...interface{}) {
-	if ERROR < l.level {
-		return
-	}
-
-	l.write(ERROR, v...)
-	os.Exit(1)
-}
-
-// Warn выводит предупреждение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
-func (l *Logger) Warn(v ...interface{}) {
-	if WARN < l.level {
-		return
-	}
-
-	l.write(WARN, v...)
-}
-
-// Info выводит информационное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
-func (l *Logger) Info(v ...interface{}) {
-	if INFO < l.level {
-		return
-	}
-
-	l.write(INFO, v...)
-}
-
-// Debug выводит отладочное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
-func (l *Logger) Debug(v ...interface{}) {
-	if DEBUG < l.level {
-		return
-	}
-
-	l.write(DEBUG, v...)
-}
-
-// Trace выводит произвольное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
-func (l *Logger) Trace(v ...interface{}) {
-	if TRACE < l.level {
-		return
-	}
-
-	l.write(TRACE, v...)
-}
-
-// IsLevel проверяет актуальность уровня логирования.
-// Возвращает true, если указанный уровень логирования пишется в журнал.
-func IsLevel(level Level) bool {
-	return std.IsLevel(level)
-}
-
-// Error выводит сообщение об ошибке и завершает работу приложения.
-// Пишет сообщение о фатальной ошибке и вызывает: os.Exit(1).
-func Error(v ...interface{}) {
-	std.Error(v...)
-}
-
-// Warn выводит предупреждение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
-func Warn(v ...interface{}) {
-	std.Warn(v...)
-}
-
-// Info выводит информационное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
-func Info(v ...interface{}) {
-	std.Info(v...)
-}
-
-// Debug выводит отладочное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
-func Debug(v ...interface{}) {
-	std.Debug(v...)
-}
-
-// Trace выводит произвольное сообщение.
-// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
-func Trace(v ...interface{}) {
-	std.Trace(v...)
-}
-
-// IsError проверяет актуальность уровня логирования: ERROR.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func IsError() bool {
-	return std.IsError()
-}
-
-// IsWarn проверяет актуальность уровня логирования: WARN.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func IsWarn() bool {
-	return std.IsWarn()
-}
-
-// IsInfo проверяет актуальность уровня логирования: INFO.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func IsInfo() bool {
-	return std.IsInfo()
-}
-
-// IsDebug проверяет актуальность уровня логирования: DEBUG.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func IsDebug() bool {
-	return std.IsDebug()
-}
-
-// IsTrace проверяет актуальность уровня логирования: TRACE.
-// Возвращает true, если сообщения этого уровня пишутся в журнал.
-func IsTrace() bool {
-	return std.IsTrace()
-}
+// Package log расширяет стандартный go логгер для вывода отладочной
+// информации о ходе работы приложения, разделяя его на несколько
+// уровней важности.
+//
+// Для каждого уровня есть три семейства методов, различающихся способом
+// составления текста сообщения из аргументов:
+//
+// - Info, Warn и т.д. - как fmt.Sprint(): пробел добавляется только между
+// соседними аргументами, которые оба не являются строками. Info("count", 5)
+// выводит "count5".
+//
+// - Infoln, Warnln и т.д. - как fmt.Sprintln(): все аргументы разделяются
+// пробелами. Infoln("count", 5) выводит "count 5".
+//
+// - Infof, Warnf и т.д. - как fmt.Sprintf() по строке формата.
+//
+// Пакет совместим со стандартным пакетом log: для перехода достаточно
+// заменить импорт. Функции стандартного пакета соответствуют уровням так:
+//
+// - Print, Printf, Println - INFO.
+//
+// - Fatal, Fatalf, Fatalln - ERROR с последующим вызовом os.Exit(1).
+//
+// - Panic, Panicf, Panicln - ERROR с последующим вызовом panic().
+//
+// Функции SetOutput, SetPrefix, Prefix, SetFlags и Flags работают с
+// дефолтным логгером. Флаги Ldate, Ltime и другие задают соответствующие
+// настройки заголовка Head*. В отличие от стандартного пакета, функция
+// Writer принимает уровень сообщений.
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Level описывает уровень важности логируемых сообщений.
+//
+// Необходим для разделения сообщений журнала по уровню важности.
+// Все доступные значения и их описание для применения перечислены в
+// соответствующих константах.
+//
+// Возможные значения:
+//
+// - TRACE - Журналы, содержащие наиболее подробные сообщения.
+// Эти сообщения могут содержать конфиденциальные данные приложения.
+// Эти сообщения по умолчанию отключены, и их никогда не следует включать
+// в рабочей среде.
+//
+// - DEBUG - Журналы, используемые для интерактивного исследования во
+// время разработки. Эти журналы в основном содержат сведения, полезные
+// при отладки и не представляющие ценности в долгосрочной перспективе.
+//
+// - INFO - Журналы, отслеживающие общий поток работы приложения.
+// Эти журналы должны быть полезны в долгосрочной перспективе.
+//
+// - WARN - Журналы, которые показывают ненормальное или неожиданное
+// событие в потоке приложения, но не вызывают прекращение выполнения
+// приложения каким-либо образом.
+//
+// - ERROR - Журналы, описывающие неустранимый сбой приложения или системы
+// либо неустранимый сбой, который требует немедленного внимания.
+type Level int32
+
+// Уровни важности логируемых сообщений.
+// Тут перечислены все доступные уровни важности и их описание для применения.
+const (
+
+	// TRACE - Журналы, содержащие наиболее подробные сообщения.
+	// Эти сообщения могут содержать конфиденциальные данные приложения.
+	// Эти сообщения по умолчанию отключены, и их никогда не следует включать
+	// в рабочей среде.
+	TRACE Level = iota
+
+	// DEBUG - Журналы, используемые для интерактивного исследования во
+	// время разработки. Эти журналы в основном содержат сведения, полезные
+	// при отладки и не представляющие ценности в долгосрочной перспективе.
+	// Используется по умолчанию.
+	DEBUG
+
+	// INFO - Журналы, отслеживающие общий поток работы приложения.
+	// Эти журналы должны быть полезны в долгосрочной перспективе.
+	INFO
+
+	// WARN - Журналы, которые показывают ненормальное или неожиданное
+	// событие в потоке приложения, но не вызывают прекращение выполнения
+	// приложения каким-либо образом.
+	WARN
+
+	// ERROR - Журналы, описывающие неустранимый сбой приложения или системы
+	// либо неустранимый сбой, который требует немедленного внимания.
+	ERROR
+)
+
+// Дефолтный логгер.
+var std = New(os.Stderr, DEBUG)
+
+// Logger описывает один экземпляр логгера.
+//
+// По умолчанию используется дефолтный экземпляр логгера, ссылку на который
+// Вы можете получить с помощью: log.Default(). Он нацелен на стандартный поток
+// вывода сообщений об ошибках. Вы также можете создать собственный экземпляр
+// логгера и нацелить его на произвольный поток вывода с помощью конструктора:
+// log.New()
+type Logger struct {
+
+	// Цветной текст.
+	//
+	// Если задано true, к тексту будет применяться раскраска с помощью
+	// управляющих ANSI символов.
+	//
+	// По умолчанию: true, если цель вывода - терминал. (См.: AutoColor)
+	Color bool
+
+	// Автоматическое определение раскраски.
+	//
+	// Если true, при создании логгера и при каждой смене цели вывода с
+	// помощью SetOutput() значение Color устанавливается в зависимости от
+	// того, является ли цель вывода терминалом. При записи в файл, канал
+	// или буфер раскраска отключается, и журнал не засоряется управляющими
+	// ANSI символами. Чтобы принудительно включить раскраску, отключите эту
+	// настройку и задайте Color = true.
+	//
+	// По умолчанию: true
+	AutoColor bool
+
+	// Раскраска текста сообщений всех уровней.
+	//
+	// Если true, текст сообщения выводится цветом его уровня (см.:
+	// SetLevelColor), а не только маркер уровня. Это упрощает просмотр
+	// нагруженного журнала. Текст ошибок раскрашивается всегда, пока
+	// включена раскраска Color.
+	//
+	// По умолчанию: false.
+	ColorBody bool
+
+	// Завершение строки сообщения.
+	//
+	// Добавляется в конце каждого сообщения и вступления журнала вместо
+	// перевода строки, например "\r\n" для консоли Windows. Пустая строка
+	// отключает завершение, что удобно для протоколов с собственным
+	// разделением сообщений. Переводы строк внутри сообщения (многострочный
+	// текст, стек вызовов) не заменяются. Раскраска всегда сбрасывается до
+	// завершения строки. Учитывается форматтерами TextFormatter,
+	// JSONFormatter и LogfmtFormatter.
+	//
+	// По умолчанию: "\n".
+	LineEnding string
+
+	// Время в UTC.
+	//
+	// Если true, логгер будет использовать нулевой часовой пояс, установленный
+	// в локальной системе.
+	//
+	// По умолчанию: true.
+	UTC bool
+
+	// Часовой пояс времени сообщений.
+	//
+	// Если задан, время всех сообщений приводится к этому часовому поясу, а
+	// флаг UTC игнорируется. Позволяет писать журнал в едином часовом поясе
+	// независимо от настроек системы, например:
+	//
+	//	loc, _ := time.LoadLocation("Europe/Moscow")
+	//	l.SetLocation(loc)
+	//
+	// По умолчанию: nil. (Используется флаг UTC)
+	Location *time.Location
+
+	// Отображение заголовка. (Целиком)
+	//
+	// Если true, логгер добавляет в каждое сообщение заголовок с системной
+	// информацией: время, уровень важности и т.п.
+	//
+	// По умолчанию: true.
+	Head bool
+
+	// Отображение уровня важности в заголовке.
+	//
+	// Если true, в заголовке каждого сообщения будет присутствовать маркер
+	// уровня важности данного сообщения: [LEVEL].
+	//
+	// По умолчанию: true.
+	HeadLevel bool
+
+	// Маркер уровня важности в нижнем регистре.
+	//
+	// Если true, название уровня в маркере выводится строчными буквами:
+	// [info].
+	//
+	// По умолчанию: false.
+	LevelLowercase bool
+
+	// Краткий маркер уровня важности.
+	//
+	// Если true, вместо полного названия уровня в маркере выводится только
+	// его первая буква: [I], [W], [E].
+	//
+	// По умолчанию: false.
+	LevelCompact bool
+
+	// Ширина маркера уровня важности.
+	//
+	// Маркеры всех уровней дополняются пробелами до этой ширины, чтобы текст
+	// сообщений начинался с одной колонки. Если 0, ширина вычисляется
+	// автоматически по самому длинному маркеру с учётом LevelLowercase и
+	// LevelCompact. Маркер длиннее заданной ширины выводится целиком.
+	//
+	// По умолчанию: 0. (Автоматически)
+	LevelWidth int
+
+	// Выравнивание маркера уровня важности.
+	//
+	// Если true, маркеры дополняются пробелами до ширины LevelWidth. Если
+	// false, после маркера любого уровня выводится ровно один пробел:
+	// "[INFO] ", "[ERROR] ". Это удобнее для программ, разбирающих журнал
+	// построчно, хотя колонка сообщений при этом не выравнивается.
+	//
+	// По умолчанию: true.
+	PadLevel bool
+
+	// Отображение значка уровня важности в заголовке.
+	//
+	// Если true, перед маркером уровня важности выводится значок: 🔍 TRACE,
+	// 🐛 DEBUG, ℹ️ INFO, ⚠️ WARN, ❌ ERROR. Чтобы выводить значок вместо
+	// маркера, отключите HeadLevel. Значки дополняются пробелами до
+	// одинаковой ширины, поэтому колонки сообщений остаются выровненными.
+	//
+	// По умолчанию: false.
+	HeadEmoji bool
+
+	// Отображение даты в заголовке.
+	//
+	// Если true, в заголовке каждого сообщения будет присутствовать дата: DD.MM.YYYY.
+	//
+	// По умолчанию: true.
+	HeadDate bool
+
+	// Отображение времени в заголовке.
+	//
+	// Если true, в заголовке каждого сообщения будет присутствовать время: HH:MM:SS.
+	//
+	// По умолчанию: true.
+	HeadTime bool
+
+	// Отображение микросекунд в заголовке. (Работает только при включенном HeadTime)
+	//
+	// Если true, в заголовке каждого сообщения будут присутствовать микросекунды: HH:MM:SS.000000
+	// Сохранено для совместимости и равнозначно TimePrecision = PrecisionMicroseconds.
+	// Если TimePrecision задан, HeadMC не учитывается.
+	//
+	// По умолчанию: false.
+	HeadMC bool
+
+	// Точность времени в заголовке. (Работает только при включенном HeadTime)
+	//
+	// Задаёт количество знаков дробной части секунд: PrecisionMilliseconds -
+	// HH:MM:SS.000, PrecisionMicroseconds - HH:MM:SS.000000,
+	// PrecisionNanoseconds - HH:MM:SS.000000000.
+	//
+	// По умолчанию: PrecisionSeconds. (Без дробной части, см. HeadMC)
+	TimePrecision TimePrecision
+
+	// Отображение местного времени вместе с UTC. (Работает только при включенном HeadTime)
+	//
+	// Если true, в заголовке выводится местное время с названием часового
+	// пояса и в скобках то же время в UTC: HH:MM:SS MSK (HH:MM:SS UTC).
+	// Основное время и дата при этом всегда местные, независимо от флага UTC.
+	// Удобно для сопоставления журнала с системами, работающими в UTC.
+	//
+	// По умолчанию: false.
+	HeadDualTime bool
+
+	// Отображение времени, прошедшего с предыдущего сообщения.
+	//
+	// Если true, в заголовке после времени выводится интервал с момента
+	// предыдущего сообщения этого логгера в секундах: +0.123s. Первое
+	// сообщение показывает +0.000s. Это позволяет быстро увидеть по журналу,
+	// на что уходит время, без профилировщика. Производные логгеры (With,
+	// Clone) ведут собственный отсчёт. (Только в текстовом формате)
+	//
+	// По умолчанию: false.
+	HeadDelta bool
+
+	// Формат времени в заголовке.
+	//
+	// Если задан, время в заголовке выводится с помощью time.Format() по
+	// этому шаблону, например: time.RFC3339 или "2006-01-02T15:04:05Z07:00".
+	// Настройки HeadDate, HeadTime, HeadMC и HeadDualTime при этом
+	// игнорируются. Флаг UTC применяется до форматирования. Если пусто,
+	// используется стандартный вид: DD.MM.YYYY HH:MM:SS.
+	//
+	// По умолчанию: "".
+	TimeLayout string
+
+	// Отображение места вызова в заголовке.
+	//
+	// Если true, в заголовке выводится имя файла и номер строки, откуда был
+	// вызван метод логгера: main.go:42. В формате JSON место вызова
+	// записывается в ключ caller. Определение места вызова требует
+	// обращения к стеку вызовов, поэтому выполняется только при включенной
+	// настройке.
+	//
+	// По умолчанию: false.
+	HeadCaller bool
+
+	// Отображение номера горутины в заголовке.
+	//
+	// Если true, в заголовке выводится номер горутины, записавшей
+	// сообщение: [g123]. Это помогает отследить один логический поток среди
+	// перемешанных сообщений разных горутин. В формате JSON номер
+	// записывается в ключ goroutine. Номер определяется разбором
+	// runtime.Stack() при каждой записи, поэтому настройку стоит включать
+	// только для отладки.
+	//
+	// По умолчанию: false.
+	HeadGoroutine bool
+
+	// Отображение порядкового номера сообщения в заголовке.
+	//
+	// Если true, в начале заголовка выводится порядковый номер сообщения:
+	// #000001. Номер увеличивается только для сообщений, записанных в
+	// журнал, поэтому пропуск номера означает потерю строки, а нарушение
+	// порядка - перестановку строк при доставке. В формате JSON номер
+	// записывается в ключ seq. Текущее значение возвращает Sequence().
+	//
+	// По умолчанию: false.
+	HeadSequence bool
+
+	// Отображение имени хоста в заголовке.
+	//
+	// Если true, в заголовке после времени выводится имя хоста, на котором
+	// работает приложение. Это позволяет различать строки разных машин при
+	// сборе журналов в одно место. Имя определяется через os.Hostname() один
+	// раз при создании логгера и может быть заменено вызовом SetHostname(),
+	// например, если в контейнере хост получает случайное имя. В формате
+	// JSON имя записывается в ключ host.
+	//
+	// По умолчанию: false.
+	HeadHostname bool
+
+	// Отображение идентификатора процесса в заголовке.
+	//
+	// Если true, в заголовке выводится идентификатор процесса: [pid 1234].
+	// Это позволяет различать строки нескольких процессов, пишущих в один
+	// файл или журнал. Идентификатор определяется один раз. В формате JSON
+	// он записывается в ключ pid.
+	//
+	// По умолчанию: false.
+	HeadPID bool
+
+	// Количество дополнительно пропускаемых кадров стека при определении
+	// места вызова. (Работает только при включенном HeadCaller)
+	//
+	// Кадры самого логгера пропускаются всегда. Если логгер вызывается из
+	// собственной обёртки приложения, укажите количество её уровней, чтобы
+	// в заголовке выводилось место вызова обёртки, а не сама обёртка.
+	//
+	// По умолчанию: 0.
+	CallerSkip int
+
+	// Имя подсистемы.
+	//
+	// Используется для группировки логгеров разных подсистем приложения,
+	// например: "db", "http". Логгер, зарегистрированный с помощью
+	// log.RegisterNamed(), получает уровень, заданный для его подсистемы
+	// через log.SetSubsystemLevel().
+	//
+	// По умолчанию: "".
+	Name string
+
+	// Вступление журнала.
+	//
+	// Если true, перед первым сообщением в каждую новую цель вывода
+	// однократно пишется строка с информацией о процессе: имя хоста, PID,
+	// время запуска и версия приложения. Это позволяет не повторять эти
+	// данные в каждом сообщении, сохраняя их в файле журнала. Производные
+	// логгеры (With, Named, Clone), пишущие в ту же цель, вступление не
+	// повторяют. Пишется в текстовом формате, JSON и logfmt, для GELF и
+	// собственных форматтеров не пишется.
+	//
+	// По умолчанию: false.
+	WritePreamble bool
+
+	// Стек вызовов в сообщениях об ошибках.
+	//
+	// Если true, к каждому сообщению уровня ERROR добавляется стек вызовов
+	// в месте записи. В текстовом формате он выводится списком с отступом
+	// под сообщением, в формате JSON - массивом объектов в ключе stack.
+	// Если среди аргументов сообщения есть ошибка, хранящая собственный
+	// стек, например, созданная пакетом github.com/pkg/errors, выводится
+	// стек этой ошибки: он указывает на место её возникновения.
+	//
+	// По умолчанию: false.
+	StackTrace bool
+
+	// Максимальная глубина стека вызовов. (Работает только при включенном StackTrace)
+	//
+	// Ограничивает количество кадров стека, добавляемых в сообщение.
+	// Значение 0 снимает ограничение.
+	//
+	// По умолчанию: 32.
+	MaxStackDepth int
+
+	// Максимальная длина текста сообщения в байтах.
+	//
+	// Если больше нуля, текст сообщения длиннее MaxBodyLen байт обрезается
+	// так, чтобы вместе с меткой "…[truncated]" уместиться в этот размер.
+	// Обрезка не разрывает многобайтовые символы UTF-8.
+	//
+	// Ограничение полезно при записи нескольких процессов в общий канал
+	// (pipe): операционная система гарантирует неделимость записи только
+	// для блоков не длиннее PIPE_BUF (4096 байт в Linux, не менее 512 байт
+	// по POSIX). Более длинные строки могут перемешаться со строками других
+	// процессов, даже если каждая записана одним вызовом Write. Размер
+	// строки складывается из заголовка, текста и полей сообщения, поэтому
+	// выбирайте значение с запасом, например: 3584 при PIPE_BUF = 4096.
+	// Значения полей ограничиваются отдельно: MaxFieldLen.
+	//
+	// По умолчанию: 0. (Без ограничения)
+	MaxBodyLen int
+
+	// Максимальная длина значения поля.
+	//
+	// Если больше нуля, значение каждого поля сообщения, длина которого в
+	// символах превышает MaxFieldLen, обрезается до этой длины и дополняется
+	// многоточием: "…". Обрезаются строки, ошибки, значения с методом
+	// String() и составные значения, представление которых слишком длинное.
+	// Числа и логические значения не изменяются. Обрезка не разрывает
+	// многобайтовые символы UTF-8 и не затрагивает остальные поля. Текст
+	// сообщения ограничивается отдельно: MaxBodyLen.
+	//
+	// По умолчанию: 0. (Без ограничения)
+	MaxFieldLen int
+
+	// Кавычки для строковых значений полей.
+	//
+	// Если true, в текстовом формате строковые значения полей, а также
+	// ошибки и значения с методом String(), содержащие пробелы, кавычки,
+	// знак "=" или управляющие символы, заключаются в двойные кавычки с
+	// экранированием. Пустые строки выводятся как "". Это позволяет
+	// однозначно разбирать текстовый вывод без перехода на формат JSON.
+	//
+	// По умолчанию: false.
+	QuoteStrings bool
+
+	// Кавычки для текста сообщения.
+	//
+	// Если true, в текстовом формате текст сообщения заключается в двойные
+	// кавычки с экранированием по тем же правилам, что и в QuoteStrings.
+	// Текст без пробелов и специальных символов выводится как есть.
+	//
+	// По умолчанию: false.
+	QuoteBody bool
+
+	// Краткий вид списков в полях.
+	//
+	// Значения полей - срезы и массивы - в текстовом формате выводятся
+	// однозначно: строки в кавычках через запятую в квадратных скобках:
+	// ["a","b","c"]. Если true, элементы выводятся через запятую без
+	// кавычек и скобок: a,b,c. Вложенные списки всегда заключаются в
+	// скобки. В формате JSON списки всегда выводятся массивами JSON.
+	//
+	// По умолчанию: false.
+	CompactSlices bool
+
+	// Повторяющиеся ключи полей.
+	//
+	// Определяет поведение log.With() при добавлении поля с уже существующим
+	// ключом. Если false, действует правило "последняя запись побеждает":
+	// значение поля заменяется, а само поле остаётся на прежнем месте. Если
+	// true, поле добавляется повторно и в сообщении выводятся все значения.
+	//
+	// По умолчанию: false.
+	AllowDuplicateKeys bool
+
+	// Сброс буфера вывода каждые N сообщений.
+	//
+	// Если больше нуля, после каждых FlushEvery записанных сообщений
+	// вызывается метод Flush() у целей вывода, которые его поддерживают,
+	// например: *bufio.Writer или *Archive. Это даёт предсказуемую
+	// гарантию сохранности для буферизированных файлов журнала. При 0
+	// буфер сбрасывается самой целью вывода: при заполнении или по таймеру.
+	//
+	// По умолчанию: 0.
+	FlushEvery int
+
+	// Ширина строки для переноса текста. (Только в текстовом формате)
+	//
+	// Если больше нуля, текст сообщения переносится по словам так, чтобы
+	// строка вместе с заголовком не превышала WrapWidth колонок терминала.
+	// Строки продолжения выравниваются пробелами под начало сообщения.
+	// Управляющие ANSI символы не учитываются в ширине, а слова длиннее
+	// доступной ширины не разрываются. При 0 текст не переносится.
+	//
+	// По умолчанию: 0.
+	WrapWidth int
+
+	// Выравнивание многострочных сообщений. (Только в текстовом формате)
+	//
+	// Если true, каждая строка продолжения сообщения, содержащего переводы
+	// строк, начинается с отступа шириной в заголовок и префикс, поэтому
+	// многострочный JSON или дамп остаётся выровненным под своим
+	// заголовком. Вместо пробелов можно задать отступ в MultilinePrefix.
+	// При включённом переносе WrapWidth строки продолжения выравниваются
+	// всегда, и эта настройка не требуется.
+	//
+	// По умолчанию: false.
+	IndentMultiline bool
+
+	// Отступ строк продолжения многострочных сообщений, например: "  | ".
+	//
+	// Используется при включённом IndentMultiline. Если пусто, отступ
+	// состоит из пробелов шириной в заголовок и префикс сообщения.
+	//
+	// По умолчанию: "".
+	MultilinePrefix string
+
+	// Код завершения приложения в Fatal, Fatalf и Fatalln.
+	//
+	// По умолчанию: 1.
+	ExitCode int
+
+	// Функция завершения приложения в Fatal, Fatalf и Fatalln.
+	//
+	// Вызывается с кодом ExitCode после записи сообщения. Позволяет передать
+	// завершение собственному обработчику или проверить Fatal в тестах, не
+	// завершая процесс тестов. Если функция вернёт управление, Fatal тоже
+	// вернёт управление. Как и Location, не входит в Config и задаётся в
+	// коде. Если nil, вызывается os.Exit().
+	//
+	// По умолчанию: nil.
+	ExitFunc func(code int)
+
+	mu        sync.RWMutex // Настройки логгера. Запись сообщений захватывает его на чтение.
+	wmu       sync.Mutex   // Атомарная запись в цели вывода.
+	out       io.Writer    // Назначение для вывода сообщений.
+	level     Level        // Уровень логируемых сообщений.
+	formatter Formatter    // Форматтер сообщений.
+	taps      *tapSet      // Подключенные перехватчики сообщений.
+	hooks     []Hook       // Подключенные хуки.
+	onError   func(error)  // Обработчик ошибок записи.
+	preamble  *atomic.Bool // Вступление записано в цель вывода. Общий для производных логгеров.
+	fields    []Field      // Поля, добавляемые к каждому сообщению.
+	outs      []io.Writer  // Дополнительные цели вывода.
+	prefix    string       // Префикс сообщений.
+	hostname  string       // Имя хоста для HeadHostname.
+
+	levelOut   [ERROR + 1]io.Writer               // Цели вывода отдельных уровней.
+	labels     [ERROR + 1]string                  // Названия уровней, заданные SetLevelLabel.
+	colors     map[Level]string                   // Цвета уровней, заданные SetLevelColor.
+	levelFiles []*RotatingFile                    // Файлы, открытые SetLevelDir.
+	unflushed  int                                // Сообщений записано с последнего сброса буфера.
+	mutes      []string                           // Шаблоны заглушённых сообщений.
+	headerFunc func(Level, time.Time) string      // Построение заголовка, см. SetHeaderFunc().
+	levelStack []Level                            // Прежние уровни, сохранённые PushLevel().
+	filter     func(Level, string) (string, bool) // Фильтр сообщений, см. SetFilter().
+	highlights []highlight                        // Правила подсветки, см. SetHighlight().
+
+	rateN   int                   // Лимит сообщений за интервал, см. SetRateLimit().
+	ratePer time.Duration         // Интервал ограничения частоты.
+	rateWin [ERROR + 1]rateWindow // Текущие окна ограничения по уровням.
+
+	dedupPer  time.Duration // Окно подавления повторов, см. SetDedup().
+	dedupList []dedupEntry  // Недавние сообщения, от давно не встречавшихся к свежим.
+
+	batchLines int           // Строк в пакете, см. SetBatch().
+	batchDelay time.Duration // Наибольшая задержка пакетной записи.
+
+	enabled atomic.Uint32            // Битовая маска активных уровней.
+	bytes   [ERROR + 1]atomic.Uint64 // Записано байт по уровням.
+	counts  [ERROR + 1]atomic.Uint64 // Записано сообщений по уровням.
+	depth   atomic.Int32             // Глубина вложенности Enter().
+	muted   atomic.Uint64            // Заглушено сообщений.
+	seq     atomic.Uint64            // Номер последнего записанного сообщения.
+	last    atomic.Int64             // Время последнего сообщения для HeadDelta, UnixNano.
+
+	keyed      sync.Map      // Состояние LogKeyed() по ключам: *keyedState.
+	keyedSweep atomic.Int64  // Время последней очистки keyed, UnixNano.
+	suppressed atomic.Uint64 // Подавлено сообщений LogKeyed(), SetRateLimit() и SetDedup().
+}
+
+// New создаёт новый логгер.
+// Вы можете указать цель назначения всех сообщений журнала.
+func New(out io.Writer, level Level) *Logger {
+	l := &Logger{
+		out:       out,
+		level:     level,
+		Color:     isTerminal(out),
+		AutoColor: true,
+		UTC:       true,
+		Head:      true,
+		HeadLevel: true,
+		HeadDate:  true,
+		HeadTime:  true,
+		HeadMC:    false,
+		PadLevel:  true,
+
+		LineEnding:    "\n",
+		MaxStackDepth: 32,
+		ExitCode:      1,
+
+		onError:  defaultErrorHandler,
+		hostname: hostname(),
+		taps:     &tapSet{},
+		preamble: new(atomic.Bool),
+	}
+	l.enabled.Store(levelMask(level))
+
+	return l
+}
+
+// Default дефолтный логгер, используемый по умолчанию.
+// Вы можете создать собственный логгер, используя вызов: log.New().
+func Default() *Logger {
+	return std
+}
+
+// Записать заголовки сообщения.
+func (l *Logger) writeHeader(buf *[]byte, e Entry) {
+	if l.headerFunc != nil {
+		*buf = append(*buf, l.headerFunc(e.Level, e.Time)...)
+		return
+	}
+
+	var start = len(*buf)
+	var level, now = e.Level, e.Time
+
+	// Номер сообщения:
+	if e.Sequence != 0 {
+		*buf = append(*buf, '#')
+		itoa(buf, int(e.Sequence), 6)
+		*buf = append(*buf, ' ')
+	}
+
+	// Значок уровня:
+	if l.HeadEmoji {
+		*buf = append(*buf, levelEmoji(level)...)
+	}
+
+	// Метка уровня:
+	if l.HeadLevel {
+		l.writeHeaderLevel(buf, level)
+	}
+
+	// Цвет заголовка:
+	if l.Color {
+		if level == ERROR {
+			*buf = append(*buf, colorError...)
+		} else {
+			*buf = append(*buf, colorHeader...)
+		}
+	}
+
+	// Заголовки:
+	if l.TimeLayout != "" {
+		*buf = now.AppendFormat(*buf, l.TimeLayout)
+		*buf = append(*buf, ' ')
+	} else if l.HeadDate || l.HeadTime {
+		if l.HeadDualTime {
+			now = now.Local()
+		}
+		if l.HeadDate {
+			year, month, day := now.Date()
+
+			itoa(buf, day, 2)
+			*buf = append(*buf, '.')
+			itoa(buf, int(month), 2)
+			*buf = append(*buf, '.')
+			itoa(buf, year, 4)
+			*buf = append(*buf, ' ')
+		}
+		if l.HeadTime {
+			l.writeClock(buf, now)
+
+			if l.HeadDualTime {
+				zone, _ := now.Zone()
+				*buf = append(*buf, ' ')
+				*buf = append(*buf, zone...)
+				*buf = append(*buf, " ("...)
+				l.writeClock(buf, now.UTC())
+				*buf = append(*buf, " UTC)"...)
+			}
+
+			*buf = append(*buf, ' ')
+		}
+	}
+
+	// Интервал:
+	if l.HeadDelta {
+		var ms = e.Delta.Milliseconds()
+		*buf = append(*buf, '+')
+		*buf = strconv.AppendInt(*buf, ms/1000, 10)
+		*buf = append(*buf, '.')
+		itoa(buf, int(ms%1000), 3)
+		*buf = append(*buf, "s "...)
+	}
+
+	// Хост:
+	if e.Hostname != "" {
+		*buf = append(*buf, e.Hostname...)
+		*buf = append(*buf, ' ')
+	}
+
+	// Процесс:
+	if e.PID != 0 {
+		*buf = append(*buf, "[pid "...)
+		*buf = strconv.AppendInt(*buf, int64(e.PID), 10)
+		*buf = append(*buf, "] "...)
+	}
+
+	// Место вызова:
+	if e.Caller != "" {
+		*buf = append(*buf, e.Caller...)
+		*buf = append(*buf, ' ')
+	}
+
+	// Горутина:
+	if e.Goroutine != 0 {
+		*buf = append(*buf, "[g"...)
+		*buf = strconv.AppendUint(*buf, e.Goroutine, 10)
+		*buf = append(*buf, "] "...)
+	}
+
+	// Конец заголовка:
+	var length = len(*buf)
+	if length == start {
+		return
+	}
+
+	*buf = (*buf)[0 : length-1]
+
+	if l.Color {
+		*buf = append(*buf, ": "...)
+		*buf = append(*buf, colorClear...)
+	} else {
+		*buf = append(*buf, ": "...)
+	}
+}
+
+// Записать время: HH:MM:SS с дробной частью секунд согласно
+// TimePrecision, например: HH:MM:SS.000000.
+func (l *Logger) writeClock(buf *[]byte, t time.Time) {
+	hour, min, sec := t.Clock()
+	itoa(buf, hour, 2)
+	*buf = append(*buf, ':')
+	itoa(buf, min, 2)
+	*buf = append(*buf, ':')
+	itoa(buf, sec, 2)
+
+	if digits := l.timePrecision().digits(); digits > 0 {
+		*buf = append(*buf, '.')
+		itoa(buf, t.Nanosecond()/pow10(9-digits), digits)
+	}
+}
+
+// Записать метку уровня логирования.
+func (l *Logger) writeHeaderLevel(buf *[]byte, level Level) {
+	if on := l.levelColor(level); l.Color && on != "" {
+		*buf = append(*buf, on...)
+		l.writeLevelMarker(buf, level)
+		*buf = append(*buf, colorClear...)
+		return
+	}
+	l.writeLevelMarker(buf, level)
+}
+
+// Записать маркер уровня, выровненный по ширине, с пробелом в конце.
+//
+// Единственное место построения маркера в заголовке: все настройки вида
+// маркера применяются в writeLevelText(), а выравнивание - здесь, поэтому
+// колонка сообщений совпадает при любом их сочетании.
+func (l *Logger) writeLevelMarker(buf *[]byte, level Level) {
+	l.writeLevelText(buf, level)
+	if !l.PadLevel {
+		*buf = append(*buf, ' ')
+		return
+	}
+
+	var width = l.LevelWidth
+	if width <= 0 {
+		for v := TRACE; v <= ERROR; v++ {
+			width = max(width, l.levelTextWidth(v))
+		}
+	}
+
+	for n := max(width-l.levelTextWidth(level), 0) + 1; n > 0; n-- {
+		*buf = append(*buf, ' ')
+	}
+}
+
+// Записать текст маркера уровня без выравнивания: [INFO].
+func (l *Logger) writeLevelText(buf *[]byte, level Level) {
+	*buf = append(*buf, '[')
+	if level >= TRACE && level <= ERROR && l.labels[level] != "" {
+		*buf = append(*buf, l.labels[level]...)
+	} else {
+		var name = levelName(level)
+		if l.LevelCompact {
+			name = name[:1]
+		}
+		for i := 0; i < len(name); i++ {
+			if c := name[i]; l.LevelLowercase && c >= 'A' && c <= 'Z' {
+				*buf = append(*buf, c+'a'-'A')
+			} else {
+				*buf = append(*buf, c)
+			}
+		}
+	}
+	*buf = append(*buf, ']')
+}
+
+// Получить ширину текста маркера уровня на экране.
+func (l *Logger) levelTextWidth(level Level) int {
+	if level >= TRACE && level <= ERROR && l.labels[level] != "" {
+		return displayWidth(l.labels[level]) + 2
+	}
+	if l.LevelCompact {
+		return 3
+	}
+	return len(levelName(level)) + 2
+}
+
+// Получить метку уровня логирования без раскраски.
+func levelLabel(level Level) string {
+	switch level {
+	case INFO:
+		return "[INFO]  "
+	case WARN:
+		return "[WARN]  "
+	case TRACE:
+		return "[TRACE] "
+	case DEBUG:
+		return "[DEBUG] "
+	default:
+		return "[ERROR] "
+	}
+}
+
+// Запись инта в строку с фиксированной длиной.
+func itoa(buf *[]byte, i int, wid int) {
+	var b [20]byte
+	bp := len(b) - 1
+	for i >= 10 || wid > 1 {
+		wid--
+		q := i / 10
+		b[bp] = byte('0' + i - q*10)
+		bp--
+		i = q
+	}
+	b[bp] = byte('0' + i)
+	*buf = append(*buf, b[bp:]...)
+}
+
+// Текст сообщения, составляемый из аргументов только при записи.
+//
+// Передаётся по значению вместо замыкания, чтобы отложенное составление
+// текста не выделяло память. Готовый текст передаётся единственным
+// аргументом: отдельное строковое поле заставило бы компилятор размещать
+// аргументы всех сообщений в куче.
+type message struct {
+	mode   byte          // Способ составления: 0 - готовый текст, 's' - Sprint, 'f' - Sprintf, 'l' - Sprintln.
+	format string        // Строка формата для Sprintf.
+	args   []interface{} // Аргументы.
+}
+
+// Составить текст сообщения.
+func (m message) String() string {
+	switch m.mode {
+	case 's':
+		return fmt.Sprint(m.args...)
+	case 'f':
+		return fmt.Sprintf(m.format, m.args...)
+	case 'l':
+		var msg = fmt.Sprintln(m.args...)
+		return msg[:len(msg)-1]
+	default:
+		return m.args[0].(string)
+	}
+}
+
+// Записать сообщение в журнал.
+// Текст сообщения составляется из аргументов как в fmt.Sprint().
+func (l *Logger) write(level Level, v ...interface{}) error {
+	return l.emit(level, message{mode: 's', args: v}, nil)
+}
+
+// Записать сообщение в журнал.
+// Текст сообщения составляется из аргументов как в fmt.Sprintf().
+func (l *Logger) writef(level Level, format string, v ...interface{}) error {
+	return l.emit(level, message{mode: 'f', format: format, args: v}, nil)
+}
+
+// Записать сообщение в журнал.
+// Текст сообщения составляется из аргументов как в fmt.Sprintln(), но без
+// завершающего перевода строки: его добавляет форматтер.
+func (l *Logger) writeln(level Level, v ...interface{}) error {
+	return l.emit(level, message{mode: 'l', args: v}, nil)
+}
+
+// Записать готовый текст сообщения в журнал.
+func (l *Logger) output(level Level, msg string) error {
+	return l.emit(level, message{args: []interface{}{msg}}, nil)
+}
+
+// Записать сообщение в журнал.
+// Текст сообщения составляется только после проверки ограничения
+// частоты, см. Logger.SetRateLimit(). Поля extra добавляются к полям
+// логгера только для этого сообщения. Ошибка записи передаётся
+// обработчику ошибок, см. Logger.SetErrorHandler().
+func (l *Logger) emit(level Level, msg message, extra []Field) error {
+	var err = l.emitLimited(level, msg, extra)
+	if err != nil {
+		l.handleError(err)
+	}
+	return err
+}
+
+// Записать сообщение в журнал с учётом ограничения частоты.
+//
+// Мьютекс логгера захватывается только на чтение, поэтому составление и
+// форматирование сообщений из разных горутин выполняется параллельно.
+// Монопольно, под мьютексом записи, выполняются лишь проверка частоты и
+// вывод готовой строки.
+func (l *Logger) emitLimited(level Level, msg message, extra []Field) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var err error
+	if l.rateN > 0 {
+		l.wmu.Lock()
+		ok, dropped := l.rateAllow(level, time.Now())
+		l.wmu.Unlock()
+		if dropped > 0 {
+			err = l.emitText(level, "... "+strconv.Itoa(dropped)+" similar messages suppressed", nil, nil)
+		}
+		if !ok {
+			return err
+		}
+	}
+
+	var text = msg.String()
+	if l.filter != nil {
+		var ok bool
+		if text, ok = l.filter(level, text); !ok {
+			return err
+		}
+	}
+	if l.dedupPer > 0 {
+		l.wmu.Lock()
+		ok, done := l.dedup(level, text, time.Now())
+		l.wmu.Unlock()
+		if e := l.writeRepeated(done); err == nil {
+			err = e
+		}
+		if !ok {
+			return err
+		}
+	}
+
+	if e := l.emitText(level, text, msg.args, extra); e != nil {
+		err = e
+	}
+
+	return err
+}
+
+// Записать готовый текст сообщения в журнал.
+// Вызывается с захваченным на чтение мьютексом логгера. Строка
+// форматируется в локальный буфер, а мьютекс записи захватывается только
+// для вывода, см. Logger.writeEntry().
+func (l *Logger) emitText(level Level, msg string, args []interface{}, extra []Field) error {
+	if len(l.mutes) > 0 && l.isMuted(msg) {
+		l.muted.Add(1)
+		return nil
+	}
+
+	var e = l.newEntry(level, msg, args, extra)
+	if seq := l.seq.Add(1); l.HeadSequence {
+		e.Sequence = seq
+	}
+	if l.HeadDelta {
+		if last := l.last.Swap(e.Time.UnixNano()); last != 0 {
+			e.Delta = time.Duration(e.Time.UnixNano() - last)
+		}
+	}
+
+	var buf = getBuffer()
+	defer putBuffer(buf)
+	l.format(buf, e)
+
+	l.wmu.Lock()
+	defer l.wmu.Unlock()
+
+	return l.writeEntry(buf, e)
+}
+
+// Составить сообщение для вывода.
+// Заполняет все сведения заголовка, кроме порядкового номера. Аргументы
+// сообщения args нужны только для поиска стека в ошибках, см. errorStack().
+// Вызывается с захваченным на чтение мьютексом логгера.
+func (l *Logger) newEntry(level Level, msg string, args []interface{}, extra []Field) Entry {
+	if l.MaxBodyLen > 0 {
+		msg = truncateBytes(msg, l.MaxBodyLen)
+	}
+
+	var fields = l.fields
+	if len(extra) > 0 {
+		fields = mergeFields(fields, extra, l.AllowDuplicateKeys)
+	}
+
+	var e = Entry{
+		Logger:  l,
+		Level:   level,
+		Time:    time.Now(),
+		Message: msg,
+		Fields:  l.limitFields(fields),
+		Depth:   int(l.depth.Load()),
+	}
+	if l.Location != nil {
+		e.Time = e.Time.In(l.Location)
+	} else if l.UTC {
+		e.Time = e.Time.UTC()
+	}
+	if l.HeadCaller {
+		e.Caller = caller(l.CallerSkip)
+	}
+	if l.HeadGoroutine {
+		e.Goroutine = goroutineID()
+	}
+	if l.HeadHostname {
+		e.Hostname = l.hostname
+	}
+	if l.HeadPID {
+		_, e.PID, _ = processInfo()
+	}
+	if l.StackTrace && level == ERROR {
+		if e.Stack = errorStack(args, l.MaxStackDepth); e.Stack == nil {
+			e.Stack = callers(l.MaxStackDepth)
+		}
+	}
+
+	return e
+}
+
+// Отформатировать сообщение установленным форматтером.
+func (l *Logger) format(buf *[]byte, e Entry) {
+	if l.formatter != nil {
+		l.formatter.Format(buf, e)
+	} else {
+		TextFormatter{}.Format(buf, e)
+	}
+}
+
+// Вывести отформатированное сообщение во все цели вывода.
+// Вызывается под мьютексом записи. Перехватчики и хуки тоже вызываются
+// здесь, чтобы получать сообщения по одному и в порядке вывода.
+func (l *Logger) writeEntry(buf *[]byte, e Entry) error {
+	if l.WritePreamble && !l.preamble.Load() && l.preamble.CompareAndSwap(false, true) {
+		var pre = getBuffer()
+		defer putBuffer(pre)
+		l.writePreamble(pre)
+		*pre = append(*pre, *buf...)
+		buf = pre
+	}
+
+	// Перехватчики:
+	var level = e.Level
+	l.taps.add(e)
+	var hookErr = l.fireHooks(level, e.Message)
+
+	// Вывод:
+	var out = l.out
+	if level >= TRACE && level <= ERROR && l.levelOut[level] != nil {
+		out = l.levelOut[level]
+	}
+	n, err := writeLevel(out, level, *buf)
+	if level >= TRACE && level <= ERROR {
+		l.bytes[level].Add(uint64(n))
+		l.counts[level].Add(1)
+	}
+	for _, w := range l.outs {
+		if _, e := writeLevel(w, level, *buf); err == nil {
+			err = e
+		}
+	}
+
+	// Сброс буфера:
+	if l.FlushEvery > 0 {
+		l.unflushed++
+		if l.unflushed >= l.FlushEvery {
+			l.unflushed = 0
+			if e := l.flushOutputs(); err == nil {
+				err = e
+			}
+		}
+	}
+
+	if err == nil {
+		err = hookErr
+	}
+
+	return err
+}
+
+// Level указывает текущий уровень важности логируемых сообщений.
+//
+// Если сообщение не соответствует уровню важности, оно не попадает в журнал.
+//
+// По умолчанию: LevelTrace. (В журнал попадают все сообщения)
+func (l *Logger) Level() Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.level
+}
+
+// SetLevel устанавливает уровень важности логируемых сообщений.
+// Доступные значения Level смотрите в константах пакета.
+func (l *Logger) SetLevel(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level = level
+	l.enabled.Store(levelMask(level))
+}
+
+// Output цель вывода сообщений лога.
+// По умолчанию: os.Stderr.
+func (l *Logger) Output() io.Writer {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.mainOutput()
+}
+
+// SetOutput устанавливает цель вывода сообщений журнала.
+// Удаляет дополнительные цели вывода, добавленные AddOutput, сбрасывает
+// направление уровней в отдельные файлы, заданное SetLevelDir, и
+// закрывает эти файлы.
+func (l *Logger) SetOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setOut(w)
+	l.outs = nil
+	if l.AutoColor {
+		l.Color = isTerminal(w)
+	}
+	l.levelOut = [ERROR + 1]io.Writer{}
+	l.preamble = new(atomic.Bool)
+
+	for _, f := range l.levelFiles {
+		f.Close()
+	}
+	l.levelFiles = nil
+}
+
+// IsLevel проверяет актуальность указанного уровня логирования.
+//
+// Это полезно, если вам нужно проверить, выводится для в данный
+// момент указанный уровень логируемых сообщений. Например, перед
+// выполнение дорогой операции для создания сообщения для лога.
+// Безопасен при одновременном изменении уровня из другой горутины.
+// Для уровней вне диапазона TRACE - ERROR возвращает false.
+//
+// Возвращает true, если указанный уровень логирования актуален.
+func (l *Logger) IsLevel(level Level) bool {
+	return l.EnabledFast(level)
+}
+
+// IsError проверяет актуальность уровня логирования: ERROR.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func (l *Logger) IsError() bool {
+	return l.IsLevel(ERROR)
+}
+
+// IsWarn проверяет актуальность уровня логирования: WARN.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func (l *Logger) IsWarn() bool {
+	return l.IsLevel(WARN)
+}
+
+// IsInfo проверяет актуальность уровня логирования: INFO.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func (l *Logger) IsInfo() bool {
+	return l.IsLevel(INFO)
+}
+
+// IsDebug проверяет актуальность уровня логирования: DEBUG.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func (l *Logger) IsDebug() bool {
+	return l.IsLevel(DEBUG)
+}
+
+// IsTrace проверяет актуальность уровня логирования: TRACE.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func (l *Logger) IsTrace() bool {
+	return l.IsLevel(TRACE)
+}
+
+// Error выводит сообщение об ошибке.
+// Работа приложения не завершается, для этого используйте: Logger.Fatal().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (l *Logger) Error(v ...interface{}) {
+	if !l.EnabledFast(ERROR) {
+		return
+	}
+
+	l.write(ERROR, v...)
+}
+
+// Warn выводит предупреждение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (l *Logger) Warn(v ...interface{}) {
+	if !l.EnabledFast(WARN) {
+		return
+	}
+
+	l.write(WARN, v...)
+}
+
+// Info выводит информационное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (l *Logger) Info(v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.write(INFO, v...)
+}
+
+// Debug выводит отладочное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (l *Logger) Debug(v ...interface{}) {
+	if !l.EnabledFast(DEBUG) {
+		return
+	}
+
+	l.write(DEBUG, v...)
+}
+
+// Trace выводит произвольное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (l *Logger) Trace(v ...interface{}) {
+	if !l.EnabledFast(TRACE) {
+		return
+	}
+
+	l.write(TRACE, v...)
+}
+
+// Errorf выводит форматированное сообщение об ошибке.
+// Аргументы обрабатываются как в fmt.Sprintf(). Работа приложения не
+// завершается, для этого используйте: Logger.Fatalf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (l *Logger) Errorf(format string, v ...interface{}) {
+	if !l.EnabledFast(ERROR) {
+		return
+	}
+
+	l.writef(ERROR, format, v...)
+}
+
+// Warnf выводит форматированное предупреждение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (l *Logger) Warnf(format string, v ...interface{}) {
+	if !l.EnabledFast(WARN) {
+		return
+	}
+
+	l.writef(WARN, format, v...)
+}
+
+// Infof выводит форматированное информационное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (l *Logger) Infof(format string, v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.writef(INFO, format, v...)
+}
+
+// Debugf выводит форматированное отладочное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (l *Logger) Debugf(format string, v ...interface{}) {
+	if !l.EnabledFast(DEBUG) {
+		return
+	}
+
+	l.writef(DEBUG, format, v...)
+}
+
+// Tracef выводит форматированное произвольное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (l *Logger) Tracef(format string, v ...interface{}) {
+	if !l.EnabledFast(TRACE) {
+		return
+	}
+
+	l.writef(TRACE, format, v...)
+}
+
+// Errorln выводит сообщение об ошибке.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln(). Работа
+// приложения не завершается, для этого используйте: Logger.Fatalln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func (l *Logger) Errorln(v ...interface{}) {
+	if !l.EnabledFast(ERROR) {
+		return
+	}
+
+	l.writeln(ERROR, v...)
+}
+
+// Warnln выводит предупреждение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func (l *Logger) Warnln(v ...interface{}) {
+	if !l.EnabledFast(WARN) {
+		return
+	}
+
+	l.writeln(WARN, v...)
+}
+
+// Infoln выводит информационное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func (l *Logger) Infoln(v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.writeln(INFO, v...)
+}
+
+// Debugln выводит отладочное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func (l *Logger) Debugln(v ...interface{}) {
+	if !l.EnabledFast(DEBUG) {
+		return
+	}
+
+	l.writeln(DEBUG, v...)
+}
+
+// Traceln выводит произвольное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func (l *Logger) Traceln(v ...interface{}) {
+	if !l.EnabledFast(TRACE) {
+		return
+	}
+
+	l.writeln(TRACE, v...)
+}
+
+// Log выводит сообщение уровня level.
+//
+// Позволяет выбирать уровень во время работы, например, при пересылке
+// сообщений внешней системы с их исходной важностью. Уровни ниже TRACE
+// пишутся как TRACE, выше ERROR - как ERROR. Работа приложения не
+// завершается даже для уровня ERROR, для этого используйте: Logger.Fatal().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: level.
+func (l *Logger) Log(level Level, v ...interface{}) {
+	level = min(max(level, TRACE), ERROR)
+	if !l.EnabledFast(level) {
+		return
+	}
+
+	l.write(level, v...)
+}
+
+// Logf выводит форматированное сообщение уровня level.
+// Аргументы обрабатываются как в fmt.Sprintf(). Подробнее смотрите: Logger.Log().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: level.
+func (l *Logger) Logf(level Level, format string, v ...interface{}) {
+	level = min(max(level, TRACE), ERROR)
+	if !l.EnabledFast(level) {
+		return
+	}
+
+	l.writef(level, format, v...)
+}
+
+// IsLevel проверяет актуальность уровня логирования.
+// Возвращает true, если указанный уровень логирования пишется в журнал.
+func IsLevel(level Level) bool {
+	return std.IsLevel(level)
+}
+
+// Error выводит сообщение об ошибке.
+// Работа приложения не завершается, для этого используйте: log.Fatal().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func Error(v ...interface{}) {
+	std.Error(v...)
+}
+
+// Warn выводит предупреждение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func Warn(v ...interface{}) {
+	std.Warn(v...)
+}
+
+// Info выводит информационное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func Info(v ...interface{}) {
+	std.Info(v...)
+}
+
+// Debug выводит отладочное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func Debug(v ...interface{}) {
+	std.Debug(v...)
+}
+
+// Trace выводит произвольное сообщение.
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func Trace(v ...interface{}) {
+	std.Trace(v...)
+}
+
+// Errorf выводит форматированное сообщение об ошибке.
+// Аргументы обрабатываются как в fmt.Sprintf(). Работа приложения не
+// завершается, для этого используйте: log.Fatalf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func Errorf(format string, v ...interface{}) {
+	std.Errorf(format, v...)
+}
+
+// Warnf выводит форматированное предупреждение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func Warnf(format string, v ...interface{}) {
+	std.Warnf(format, v...)
+}
+
+// Infof выводит форматированное информационное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func Infof(format string, v ...interface{}) {
+	std.Infof(format, v...)
+}
+
+// Debugf выводит форматированное отладочное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func Debugf(format string, v ...interface{}) {
+	std.Debugf(format, v...)
+}
+
+// Tracef выводит форматированное произвольное сообщение.
+// Аргументы обрабатываются как в fmt.Sprintf().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func Tracef(format string, v ...interface{}) {
+	std.Tracef(format, v...)
+}
+
+// Errorln выводит сообщение об ошибке.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln(). Работа
+// приложения не завершается, для этого используйте: log.Fatalln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: ERROR.
+func Errorln(v ...interface{}) {
+	std.Errorln(v...)
+}
+
+// Warnln выводит предупреждение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: WARN.
+func Warnln(v ...interface{}) {
+	std.Warnln(v...)
+}
+
+// Infoln выводит информационное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: INFO.
+func Infoln(v ...interface{}) {
+	std.Infoln(v...)
+}
+
+// Debugln выводит отладочное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: DEBUG.
+func Debugln(v ...interface{}) {
+	std.Debugln(v...)
+}
+
+// Traceln выводит произвольное сообщение.
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln().
+// Вызов игнорируется, если уровень важности логируемых сообщений не соответствует: TRACE.
+func Traceln(v ...interface{}) {
+	std.Traceln(v...)
+}
+
+// Log выводит сообщение уровня level.
+// Работа приложения не завершается даже для уровня ERROR.
+// Подробнее смотрите: Logger.Log().
+func Log(level Level, v ...interface{}) {
+	std.Log(level, v...)
+}
+
+// Logf выводит форматированное сообщение уровня level.
+// Аргументы обрабатываются как в fmt.Sprintf(). Подробнее смотрите: Logger.Log().
+func Logf(level Level, format string, v ...interface{}) {
+	std.Logf(level, format, v...)
+}
+
+// IsError проверяет актуальность уровня логирования: ERROR.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func IsError() bool {
+	return std.IsError()
+}
+
+// IsWarn проверяет актуальность уровня логирования: WARN.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func IsWarn() bool {
+	return std.IsWarn()
+}
+
+// IsInfo проверяет актуальность уровня логирования: INFO.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func IsInfo() bool {
+	return std.IsInfo()
+}
+
+// IsDebug проверяет актуальность уровня логирования: DEBUG.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func IsDebug() bool {
+	return std.IsDebug()
+}
+
+// IsTrace проверяет актуальность уровня логирования: TRACE.
+// Возвращает true, если сообщения этого уровня пишутся в журнал.
+func IsTrace() bool {
+	return std.IsTrace()
+}
@@ -1,14 +1,323 @@
-package log
-
-import "testing"
-
-func TestPrint(t *testing.T) {
-	Default().SetLevel(TRACE)
-
-	Info("Информационное сообщение")
-	Debug("Сообщение отладки")
-	Trace("Любой, произвольный текст")
-	Warn("Предупреждение")
-	//Error("Пример текста фатальной ошибки")
-	Default().write(ERROR, "Пример текста фатальной ошибки")
-}
+package log
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPrint(t *testing.T) {
+	Default().SetLevel(TRACE)
+
+	Info("Информационное сообщение")
+	Debug("Сообщение отладки")
+	Trace("Любой, произвольный текст")
+	Warn("Предупреждение")
+	//Error("Пример текста фатальной ошибки")
+	Default().write(ERROR, "Пример текста фатальной ошибки")
+}
+
+func TestHeadDualTime(t *testing.T) {
+	l := NewTestLogger(nil)
+	l.HeadLevel = false
+	l.HeadDate = false
+	l.HeadDualTime = true
+
+	old := time.Local
+	time.Local = time.FixedZone("MSK", 3*60*60)
+	defer func() { time.Local = old }()
+
+	var b []byte
+	l.writeHeader(&b, Entry{Level: INFO, Time: time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)})
+	if string(b) != "10:00:00 MSK (07:00:00 UTC): " {
+		t.Errorf("неверный заголовок: %q", b)
+	}
+}
+
+// Получить метку уровня в заголовке.
+func headerLevel(l *Logger, level Level) string {
+	var b []byte
+	l.writeHeaderLevel(&b, level)
+	return string(b)
+}
+
+// Получить маркер уровня без раскраски.
+func levelMarker(l *Logger, level Level) string {
+	var b []byte
+	l.writeLevelMarker(&b, level)
+	return string(b)
+}
+
+func TestLevelMarker(t *testing.T) {
+	for _, c := range []struct {
+		lower, compact bool
+		width          int
+		want           [ERROR + 1]string
+	}{
+		{false, false, 0, [...]string{"[TRACE] ", "[DEBUG] ", "[INFO]  ", "[WARN]  ", "[ERROR] "}},
+		{true, false, 0, [...]string{"[trace] ", "[debug] ", "[info]  ", "[warn]  ", "[error] "}},
+		{false, true, 0, [...]string{"[T] ", "[D] ", "[I] ", "[W] ", "[E] "}},
+		{true, true, 0, [...]string{"[t] ", "[d] ", "[i] ", "[w] ", "[e] "}},
+		{false, true, 5, [...]string{"[T]   ", "[D]   ", "[I]   ", "[W]   ", "[E]   "}},
+		{false, false, 3, [...]string{"[TRACE] ", "[DEBUG] ", "[INFO] ", "[WARN] ", "[ERROR] "}},
+	} {
+		l := New(io.Discard, TRACE)
+		l.LevelLowercase = c.lower
+		l.LevelCompact = c.compact
+		l.LevelWidth = c.width
+
+		for v := TRACE; v <= ERROR; v++ {
+			if got := levelMarker(l, v); got != c.want[v] {
+				t.Errorf("%+v: маркер %q, ожидалось %q", c, got, c.want[v])
+			}
+			l.Color = true
+			if got := StripANSI(headerLevel(l, v)); got != c.want[v] {
+				t.Errorf("%+v: цветной маркер %q, ожидалось %q", c, got, c.want[v])
+			}
+			l.Color = false
+		}
+	}
+}
+
+func TestPadLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.PadLevel = false
+	l.LevelWidth = 10
+
+	for v := TRACE; v <= ERROR; v++ {
+		if got, want := levelMarker(l, v), "["+levelName(v)+"] "; got != want {
+			t.Errorf("маркер %q, ожидалось %q", got, want)
+		}
+	}
+
+	l.Info("a")
+	if s := buf.String(); s != "[INFO]: a\n" {
+		t.Errorf("неверный вывод: %q", s)
+	}
+}
+
+// Считает вызовы String() для проверки ленивого форматирования.
+type countStringer struct{ n *int }
+
+func (s countStringer) String() string {
+	*s.n++
+	return "значение"
+}
+
+func TestPrintf(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(INFO)
+
+	var calls int
+	l.Debugf("скрыто: %v", countStringer{&calls})
+	l.Infof("порт %d, хост %q: %v", 8080, "localhost", countStringer{&calls})
+	l.Warnf("%.1f%%", 99.5)
+
+	if want := "порт 8080, хост \"localhost\": значение\n99.5%\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if calls != 1 {
+		t.Errorf("аргументы отфильтрованного сообщения не должны форматироваться: %d", calls)
+	}
+}
+
+func TestPrintln(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.Info("count", 5, "x")
+	l.Infoln("count", 5, "x")
+	l.Warnln()
+
+	if want := "count5x\ncount 5 x\n\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestErrorNoExit(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.Error("первая")
+	l.Errorf("вторая: %d", 2)
+	l.Errorln("третья", 3)
+
+	if want := "первая\nвторая: 2\nтретья 3\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestTimeLayout(t *testing.T) {
+	l := NewTestLogger(nil)
+	l.HeadLevel = false
+	l.HeadMC = true
+	l.TimeLayout = time.RFC3339
+
+	var b []byte
+	l.writeHeader(&b, Entry{Level: INFO, Time: time.Date(2024, 5, 1, 7, 0, 0, 0, time.UTC)})
+	if string(b) != "2024-05-01T07:00:00Z: " {
+		t.Errorf("неверный заголовок: %q", b)
+	}
+}
+
+func TestSetLocation(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.TimeLayout = "Z07:00"
+	l.HeadLevel = false
+
+	l.SetLocation(time.FixedZone("MSK", 3*60*60))
+	l.Info("a")
+	l.SetLocation(nil)
+	l.Info("b")
+
+	if want := "+03:00: a\nZ: b\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestLineEnding(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.StackTrace = true
+	l.MaxStackDepth = 1
+
+	l.LineEnding = "\r\n"
+	l.Info("a")
+	l.write(ERROR, "b")
+	l.LineEnding = ""
+	l.Info("c")
+	l.SetFormat(FormatJSON)
+	l.Info("d")
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "a\r\nb\n    ") {
+		t.Errorf("неверное начало вывода: %q", out)
+	}
+	if !strings.Contains(out, ")\r\nc{") || !strings.HasSuffix(out, "}") {
+		t.Errorf("неверное завершение строк: %q", out)
+	}
+}
+
+func TestConcurrentWrite(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.WritePreamble = true
+	l.SetRateLimit(1000, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.Info("сообщение ", j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 1001 || !strings.HasPrefix(lines[0], "# host=") {
+		t.Fatalf("записано %d строк, ожидалось 1001 с вступлением", len(lines))
+	}
+	for _, v := range lines[1:] {
+		if !strings.HasPrefix(v, "сообщение ") {
+			t.Fatalf("повреждённая строка: %q", v)
+		}
+	}
+}
+
+func BenchmarkInfoConcurrent(b *testing.B) {
+	l := New(io.Discard, TRACE)
+	l.Color = false
+	b.ReportAllocs()
+
+	const workers = 100
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				l.Info("сообщение ", j, " из ", n)
+			}
+		}(b.N/workers + 1)
+	}
+	wg.Wait()
+}
+
+func TestHeadPID(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadLevel = false
+	l.HeadHostname = true
+	l.HeadPID = true
+	l.SetHostname("web-1")
+
+	l.Info("a")
+	l.SetFormat(FormatJSON)
+	l.Info("b")
+
+	pid := strconv.Itoa(os.Getpid())
+	if want := "web-1 [pid " + pid + "]: a\n"; !strings.HasSuffix(strings.SplitAfter(buf.String(), "\n")[0], want) {
+		t.Errorf("неверный заголовок: %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), `"host":"web-1","pid":`+pid+`}`) {
+		t.Errorf("в JSON нет идентификатора процесса: %q", buf.String())
+	}
+}
+
+func TestLog(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(WARN)
+
+	l.Log(INFO, "a")
+	l.Log(WARN, "b")
+	l.Logf(ERROR, "c%d", 1)
+	l.Log(ERROR+3, "d")
+	l.Log(TRACE-1, "e")
+
+	if want := "b\nc1\nd\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestHeadDelta(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadLevel = false
+	l.HeadDelta = true
+
+	l.Info("a")
+	time.Sleep(20 * time.Millisecond)
+	l.Info("b")
+
+	lines := strings.Split(buf.String(), "\n")
+	if !regexp.MustCompile(`^\d\d:\d\d:\d\d \+0\.000s: a$`).MatchString(lines[0]) {
+		t.Errorf("неверная первая строка: %q", lines[0])
+	}
+	m := regexp.MustCompile(`^\d\d:\d\d:\d\d \+0\.(\d{3})s: b$`).FindStringSubmatch(lines[1])
+	if m == nil || m[1] < "020" || m[1] > "500" {
+		t.Errorf("неверный интервал: %q", lines[1])
+	}
+}
@@ -0,0 +1,51 @@
+package log
+
+import "fmt"
+
+// LogErr записывает ошибку в журнал с уровнем ERROR и возвращает её без
+// изменений. Работа приложения не завершается.
+//
+// Позволяет сократить типичную обработку ошибок до одной строки:
+//
+//	if err != nil {
+//		return l.LogErr(err)
+//	}
+//
+// Если err равен nil, ничего не записывается и возвращается nil.
+func (l *Logger) LogErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if l.EnabledFast(ERROR) {
+		l.write(ERROR, err)
+	}
+
+	return err
+}
+
+// WrapErr оборачивает ошибку сообщением msg, записывает результат в журнал
+// с уровнем ERROR и возвращает его. Работа приложения не завершается.
+//
+// Текст новой ошибки имеет вид: "msg: err". Исходная ошибка доступна через
+// errors.Is() и errors.As(). Если err равен nil, ничего не записывается и
+// возвращается nil.
+func (l *Logger) WrapErr(msg string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return l.LogErr(fmt.Errorf("%s: %w", msg, err))
+}
+
+// LogErr записывает ошибку в дефолтный логгер и возвращает её без изменений.
+// Подробнее смотрите: Logger.LogErr().
+func LogErr(err error) error {
+	return std.LogErr(err)
+}
+
+// WrapErr оборачивает ошибку, записывает её в дефолтный логгер и возвращает.
+// Подробнее смотрите: Logger.WrapErr().
+func WrapErr(msg string, err error) error {
+	return std.WrapErr(msg, err)
+}
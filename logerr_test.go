@@ -0,0 +1,47 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestLogErr(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	if err := l.LogErr(nil); err != nil || buf.Len() != 0 {
+		t.Fatalf("nil ошибка должна игнорироваться: %v %q", err, buf.String())
+	}
+
+	orig := errors.New("нет соединения")
+	if err := l.LogErr(orig); err != orig {
+		t.Errorf("ошибка должна возвращаться без изменений: %v", err)
+	}
+	if buf.String() != "нет соединения\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
+
+func TestWrapErr(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	if err := l.WrapErr("загрузка", nil); err != nil || buf.Len() != 0 {
+		t.Fatalf("nil ошибка должна игнорироваться: %v %q", err, buf.String())
+	}
+
+	orig := errors.New("нет соединения")
+	err := l.WrapErr("загрузка конфигурации", orig)
+	if !errors.Is(err, orig) {
+		t.Errorf("исходная ошибка недоступна через errors.Is: %v", err)
+	}
+	if err.Error() != "загрузка конфигурации: нет соединения" {
+		t.Errorf("неверный текст ошибки: %q", err.Error())
+	}
+	if buf.String() != "загрузка конфигурации: нет соединения\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
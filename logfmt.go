@@ -0,0 +1,83 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// LogfmtFormatter выводит сообщения в формате logfmt: пары key=value,
+// разделённые пробелами.
+//
+// Строка содержит ключи: time (RFC 3339), level (в нижнем регистре), msg,
+// затем поля сообщения и, если был собран, стек вызовов в ключе stack:
+//
+//	time=2021-01-02T15:04:05Z level=info msg="сервер запущен" port=8080
+//
+// Значения, содержащие пробелы, кавычки, знак "=" или управляющие символы,
+// а также пустые значения заключаются в двойные кавычки с экранированием.
+// Раскраска и настройки заголовка логгера не применяются.
+type LogfmtFormatter struct{}
+
+// Format дописывает в буфер сообщение в формате logfmt.
+func (LogfmtFormatter) Format(buf *[]byte, e Entry) {
+	*buf = append(*buf, "time="...)
+	*buf = e.Time.AppendFormat(*buf, time.RFC3339Nano)
+	*buf = append(*buf, " level="...)
+	*buf = append(*buf, strings.ToLower(levelName(e.Level))...)
+	*buf = append(*buf, " msg="...)
+	appendLogfmtValue(buf, e.Message)
+	for _, f := range e.Fields {
+		*buf = append(*buf, ' ')
+		*buf = append(*buf, logfmtKey(f.Key)...)
+		*buf = append(*buf, '=')
+		if f.Value == nil {
+			*buf = append(*buf, "null"...)
+		} else {
+			appendLogfmtValue(buf, fmt.Sprint(f.Value))
+		}
+	}
+	if len(e.Stack) > 0 {
+		*buf = append(*buf, " stack="...)
+		appendLogfmtValue(buf, strings.TrimSuffix(stackText(e.Stack), "\n"))
+	}
+	*buf = append(*buf, lineEnding(e)...)
+}
+
+// Записать значение logfmt, при необходимости в кавычках.
+func appendLogfmtValue(buf *[]byte, s string) {
+	if needsQuote(s) {
+		*buf = strconv.AppendQuote(*buf, s)
+	} else {
+		*buf = append(*buf, s...)
+	}
+}
+
+// Проверить, требуется ли заключить значение в кавычки.
+func needsQuote(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, r := range s {
+		if r == '"' || r == '=' || r == '\\' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Получить ключ logfmt.
+// Пробелы, кавычки, знак "=" и управляющие символы заменяются на "_".
+func logfmtKey(key string) string {
+	if key == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '"' || r == '=' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return '_'
+		}
+		return r
+	}, key)
+}
@@ -0,0 +1,41 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogfmtFormatter(t *testing.T) {
+	var buf []byte
+	LogfmtFormatter{}.Format(&buf, Entry{
+		Level:   INFO,
+		Time:    time.Date(2021, 1, 2, 15, 4, 5, 0, time.UTC),
+		Message: "сервер запущен",
+		Fields: []Field{
+			{"port", 8080},
+			{"path", `C:\tmp`},
+			{"quote", `a "b"`},
+			{"empty", ""},
+			{"nil", nil},
+			{"bad key", "x=y"},
+		},
+	})
+
+	want := `time=2021-01-02T15:04:05Z level=info msg="сервер запущен" port=8080 path="C:\\tmp" quote="a \"b\"" empty="" nil=null bad_key="x=y"` + "\n"
+	if string(buf) != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf, want)
+	}
+}
+
+func TestFormatLogfmt(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, INFO)
+	l.SetFormat(FormatLogfmt)
+	l.With("user", "иван").Warn("вход")
+
+	if !strings.HasPrefix(buf.String(), "time=") || !strings.HasSuffix(buf.String(), " level=warn msg=вход user=иван\n") {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+}
@@ -0,0 +1,60 @@
+package log
+
+import (
+	"bytes"
+	"sync"
+)
+
+// MemorySink хранит вывод логгера в памяти для проверок в тестах.
+//
+// Является целью вывода логгера (io.Writer) и одновременно перехватчиком
+// Recorder, поэтому в тесте доступны и итоговый текст журнала, и
+// структурированные записи без разбора отформатированных строк. Сообщения
+// производных логгеров (With, Named) попадают в оба представления:
+//
+//	l, sink := log.NewTest()
+//	l.Warn("диск заполнен")
+//	if sink.Records()[0].Level != log.WARN { ... }
+//
+// Создаётся с помощью конструктора: log.NewTest().
+type MemorySink struct {
+	*Recorder
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// NewTest создаёт логгер для использования в тестах и подключенный к нему
+// MemorySink.
+//
+// Логгер настроен как NewTestLogger(): пишет все сообщения начиная с
+// уровня TRACE и не использует раскраску.
+func NewTest() (*Logger, *MemorySink) {
+	s := &MemorySink{}
+	l := NewTestLogger(s)
+	s.Recorder = NewRecorder(l)
+	return l, s
+}
+
+// Write дописывает отформатированный текст журнала.
+func (s *MemorySink) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buf.Write(p)
+}
+
+// String возвращает весь записанный текст журнала.
+func (s *MemorySink) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buf.String()
+}
+
+// Reset удаляет записанный текст и перехваченные записи.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	s.buf.Reset()
+	s.mu.Unlock()
+
+	s.Recorder.Reset()
+}
@@ -0,0 +1,39 @@
+package log
+
+import "testing"
+
+func TestNewTest(t *testing.T) {
+	l, sink := NewTest()
+	l.Head = false
+	l.Warn("диск заполнен")
+	l.Info("готово")
+
+	recs := sink.Records()
+	if len(recs) != 2 || recs[0].Level != WARN || recs[0].Message != "диск заполнен" {
+		t.Fatalf("неверные записи: %+v", recs)
+	}
+	if want := "диск заполнен\nготово\n"; sink.String() != want {
+		t.Errorf("неверный текст %q, ожидалось %q", sink.String(), want)
+	}
+	sink.AssertContains(t, INFO, "готово")
+
+	sink.Reset()
+	if sink.String() != "" || len(sink.Records()) != 0 {
+		t.Errorf("Reset() не очистил журнал")
+	}
+}
+
+func TestNewTestDerived(t *testing.T) {
+	l, sink := NewTest()
+	l.Head = false
+	l.With("id", 1).Info("a")
+	l.Named("db").Warn("b")
+
+	recs := sink.Records()
+	if len(recs) != 2 || recs[0].Message != "a" || recs[1].Message != "b" {
+		t.Errorf("неверные записи: %+v", recs)
+	}
+	if want := "a id=1\ndb b\n"; sink.String() != want {
+		t.Errorf("неверный текст %q, ожидалось %q", sink.String(), want)
+	}
+}
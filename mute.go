@@ -0,0 +1,112 @@
+package log
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Mute заглушает сообщения, соответствующие шаблону pattern.
+//
+// Шаблон без символов подстановки ищется как подстрока текста сообщения.
+// Шаблон с символами подстановки сравнивается со всем текстом сообщения:
+// * - любая последовательность символов, ? - любой один символ. Например,
+// шаблон "cache miss" заглушит все сообщения, содержащие эту фразу, а
+// "retry * of 3" - только сообщения, целиком подходящие под шаблон.
+//
+// Заглушённые сообщения не пишутся в журнал и не передаются перехватчикам,
+// а учитываются в счётчике: Logger.MutedCount(). В отличие от изменения
+// уровня это позволяет убрать из журнала конкретное известное сообщение,
+// не меняя код. Повторное добавление шаблона ничего не делает.
+func (l *Logger) Mute(pattern string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, v := range l.mutes {
+		if v == pattern {
+			return
+		}
+	}
+	l.mutes = append(l.mutes, pattern)
+}
+
+// Unmute удаляет шаблон, добавленный с помощью Logger.Mute().
+func (l *Logger) Unmute(pattern string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, v := range l.mutes {
+		if v == pattern {
+			l.mutes = append(l.mutes[:i:i], l.mutes[i+1:]...)
+			return
+		}
+	}
+}
+
+// Muted возвращает список действующих шаблонов заглушённых сообщений.
+func (l *Logger) Muted() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.mutes...)
+}
+
+// MutedCount возвращает количество сообщений, не записанных в журнал
+// из-за шаблонов Logger.Mute().
+func (l *Logger) MutedCount() uint64 {
+	return l.muted.Load()
+}
+
+// Проверить, заглушено ли сообщение.
+func (l *Logger) isMuted(msg string) bool {
+	for _, p := range l.mutes {
+		if strings.ContainsAny(p, "*?") {
+			if matchGlob(p, msg) {
+				return true
+			}
+		} else if strings.Contains(msg, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Сравнить строку с шаблоном, содержащим символы подстановки * и ?.
+func matchGlob(pattern, s string) bool {
+	var star = -1 // Позиция последней * в шаблоне.
+	var back int  // Позиция в строке, с которой сопоставлена эта *.
+	var p, i int
+
+	for i < len(s) {
+		if p < len(pattern) {
+			switch pattern[p] {
+			case '*':
+				star, back = p, i
+				p++
+				continue
+			case '?':
+				_, size := utf8.DecodeRuneInString(s[i:])
+				p++
+				i += size
+				continue
+			default:
+				if pattern[p] == s[i] {
+					p++
+					i++
+					continue
+				}
+			}
+		}
+
+		if star < 0 {
+			return false
+		}
+
+		_, size := utf8.DecodeRuneInString(s[back:])
+		back += size
+		p, i = star+1, back
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,53 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	for _, c := range []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"*", "", true},
+		{"abc", "abc", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abbbd", false},
+		{"повтор ? из 3", "повтор 2 из 3", true},
+		{"повтор ? из 3", "повтор 12 из 3", false},
+		{"*из*", "повтор 12 из 3", true},
+		{"a*b*c", "aXbYbZc", true},
+		{"a*b*c", "aXbYbZ", false},
+	} {
+		if got := matchGlob(c.pattern, c.s); got != c.want {
+			t.Errorf("matchGlob(%q, %q) = %v, ожидалось %v", c.pattern, c.s, got, c.want)
+		}
+	}
+}
+
+func TestMute(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.Mute("cache miss")
+	l.Mute("retry * of 3")
+	l.Mute("cache miss")
+
+	l.Info("key 1: cache miss")
+	l.Info("retry 2 of 3")
+	l.Info("retry 2 of 5")
+	l.Unmute("cache miss")
+	l.Info("key 2: cache miss")
+
+	if buf.String() != "retry 2 of 5\nkey 2: cache miss\n" {
+		t.Errorf("неверный вывод: %q", buf.String())
+	}
+	if l.MutedCount() != 2 {
+		t.Errorf("заглушено %d сообщений, ожидалось 2", l.MutedCount())
+	}
+	if m := l.Muted(); len(m) != 1 || m[0] != "retry * of 3" {
+		t.Errorf("неверный список шаблонов: %q", m)
+	}
+}
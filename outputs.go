@@ -0,0 +1,44 @@
+package log
+
+import "io"
+
+// AddOutput добавляет цель вывода сообщений журнала.
+//
+// Каждое сообщение записывается в основную цель вывода (см.: SetOutput) и
+// во все добавленные цели в порядке их добавления. Если запись в одну из
+// целей завершилась ошибкой, остальные всё равно получают сообщение, а
+// метод записи возвращает первую ошибку. Направление уровней в отдельные
+// цели (SetLevelDir) заменяет только основную цель. Вызов SetOutput
+// удаляет все добавленные цели.
+func (l *Logger) AddOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.outs = append(l.outs[:len(l.outs):len(l.outs)], w)
+}
+
+// RemoveOutput удаляет цель вывода сообщений журнала.
+//
+// Если w - основная цель вывода, её место занимает первая из добавленных
+// с помощью AddOutput, а если их нет - io.Discard. Цель сравнивается с
+// помощью оператора ==, поэтому передавайте то же значение, что и при
+// добавлении, например указатель на файл. Отсутствующая цель игнорируется.
+func (l *Logger) RemoveOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, v := range l.outs {
+		if v == w {
+			l.outs = append(l.outs[:i:i], l.outs[i+1:]...)
+			return
+		}
+	}
+
+	if l.mainOutput() == w {
+		if len(l.outs) > 0 {
+			l.setOut(l.outs[0])
+			l.outs = l.outs[1:]
+		} else {
+			l.setOut(io.Discard)
+		}
+	}
+}
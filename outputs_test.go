@@ -0,0 +1,41 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// Цель вывода, всегда возвращающая ошибку.
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("сбой записи")
+}
+
+func TestAddOutput(t *testing.T) {
+	var a, b bytes.Buffer
+	l := NewTestLogger(&a)
+	l.Head = false
+	fail := &failWriter{}
+
+	l.AddOutput(fail)
+	l.AddOutput(&b)
+	if err := l.write(INFO, "раз"); err == nil || err.Error() != "сбой записи" {
+		t.Errorf("ожидалась ошибка записи: %v", err)
+	}
+
+	l.RemoveOutput(fail)
+	l.RemoveOutput(&a)
+	l.Info("два")
+
+	if a.String() != "раз\n" || b.String() != "раз\nдва\n" {
+		t.Errorf("неверный вывод: %q %q", a.String(), b.String())
+	}
+
+	l.SetOutput(&a)
+	l.Info("три")
+	if a.String() != "раз\nтри\n" || b.String() != "раз\nдва\n" {
+		t.Errorf("SetOutput должен удалять дополнительные цели: %q %q", a.String(), b.String())
+	}
+}
@@ -0,0 +1,61 @@
+package log
+
+import "fmt"
+
+// Panic выводит сообщение об ошибке и вызывает panic().
+//
+// Значение паники - строка с текстом записанного сообщения, поэтому
+// обработчик recover() может её проверить. В отличие от Logger.Fatal(),
+// работа приложения не завершается сразу, а паника может быть перехвачена,
+// например промежуточным обработчиком HTTP запросов. Паника вызывается,
+// даже если сообщение не записано из-за уровня важности логируемых
+// сообщений. Мьютекс логгера освобождается до вызова panic().
+func (l *Logger) Panic(v ...interface{}) {
+	var msg = fmt.Sprint(v...)
+	if l.EnabledFast(ERROR) {
+		l.output(ERROR, msg)
+	}
+	panic(msg)
+}
+
+// Panicf выводит форматированное сообщение об ошибке и вызывает panic().
+// Аргументы обрабатываются как в fmt.Sprintf(). Подробнее смотрите:
+// Logger.Panic().
+func (l *Logger) Panicf(format string, v ...interface{}) {
+	var msg = fmt.Sprintf(format, v...)
+	if l.EnabledFast(ERROR) {
+		l.output(ERROR, msg)
+	}
+	panic(msg)
+}
+
+// Panicln выводит сообщение об ошибке и вызывает panic().
+// Аргументы всегда разделяются пробелами, как в fmt.Sprintln(). Значение
+// паники не содержит завершающего перевода строки. Подробнее смотрите:
+// Logger.Panic().
+func (l *Logger) Panicln(v ...interface{}) {
+	var msg = fmt.Sprintln(v...)
+	msg = msg[:len(msg)-1]
+	if l.EnabledFast(ERROR) {
+		l.output(ERROR, msg)
+	}
+	panic(msg)
+}
+
+// Panic выводит сообщение об ошибке в дефолтный логгер и вызывает panic().
+// Подробнее смотрите: Logger.Panic().
+func Panic(v ...interface{}) {
+	std.Panic(v...)
+}
+
+// Panicf выводит форматированное сообщение об ошибке в дефолтный логгер и
+// вызывает panic(). Подробнее смотрите: Logger.Panicf().
+func Panicf(format string, v ...interface{}) {
+	std.Panicf(format, v...)
+}
+
+// Panicln выводит сообщение об ошибке в дефолтный логгер и вызывает
+// panic(). Подробнее смотрите: Logger.Panicln().
+func Panicln(v ...interface{}) {
+	std.Panicln(v...)
+}
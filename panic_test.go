@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Вызвать функцию и вернуть значение паники.
+func recoverPanic(fn func()) (v interface{}) {
+	defer func() { v = recover() }()
+	fn()
+	return nil
+}
+
+func TestPanic(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	for _, c := range []struct {
+		fn   func()
+		want string
+	}{
+		{func() { l.Panic("сбой", 1) }, "сбой1"},
+		{func() { l.Panicf("сбой %d", 2) }, "сбой 2"},
+		{func() { l.Panicln("сбой", 3) }, "сбой 3"},
+	} {
+		buf.Reset()
+		if v := recoverPanic(c.fn); v != c.want {
+			t.Errorf("значение паники %q, ожидалось %q", v, c.want)
+		}
+		if buf.String() != c.want+"\n" {
+			t.Errorf("неверный вывод: %q", buf.String())
+		}
+	}
+
+	// Мьютекс должен быть освобождён после паники:
+	l.Info("после паники")
+}
@@ -0,0 +1,33 @@
+package log
+
+import "sync"
+
+// Пул буферов для сборки сообщений.
+//
+// Буфер берётся из пула на время записи одного сообщения, поэтому
+// многочисленные производные логгеры (With, Clone) не держат каждый
+// собственный буфер, а запись не выделяет память под текст сообщения.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 1024)
+		return &b
+	},
+}
+
+// Максимальная ёмкость буфера, возвращаемого в пул.
+// Буферы, выросшие на очень длинных сообщениях, отдаются сборщику мусора.
+const maxPooledBuffer = 64 << 10
+
+// Получить пустой буфер из пула.
+func getBuffer() *[]byte {
+	b := bufferPool.Get().(*[]byte)
+	*b = (*b)[:0]
+	return b
+}
+
+// Вернуть буфер в пул.
+func putBuffer(b *[]byte) {
+	if cap(*b) <= maxPooledBuffer {
+		bufferPool.Put(b)
+	}
+}
@@ -0,0 +1,37 @@
+package log
+
+import (
+	"io"
+	"testing"
+)
+
+func TestPutBufferLarge(t *testing.T) {
+	b := make([]byte, 0, maxPooledBuffer+1)
+	putBuffer(&b)
+
+	for i := 0; i < 10; i++ {
+		if p := getBuffer(); cap(*p) > maxPooledBuffer {
+			t.Fatal("в пул возвращён слишком большой буфер")
+		}
+	}
+}
+
+func BenchmarkInfo(b *testing.B) {
+	l := New(io.Discard, TRACE)
+	l.Color = false
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.Info("сообщение ", i)
+	}
+}
+
+func BenchmarkInfoColor(b *testing.B) {
+	l := New(io.Discard, TRACE)
+	l.Color = true
+	l.SetPrefix("app")
+	l.ColorBody = true
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.Info("сообщение ", i)
+	}
+}
@@ -0,0 +1,93 @@
+package log
+
+import (
+	"os"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Время запуска процесса.
+var startTime = time.Now()
+
+// Сведения о процессе для вступления журнала.
+var process struct {
+	once    sync.Once
+	host    string
+	pid     int
+	version string
+}
+
+// Получить сведения о процессе.
+// Вычисляются один раз при первом обращении.
+func processInfo() (host string, pid int, version string) {
+	process.once.Do(func() {
+		process.host, _ = os.Hostname()
+		process.pid = os.Getpid()
+		process.version = "unknown"
+		if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+			process.version = info.Main.Version
+		}
+	})
+
+	return process.host, process.pid, process.version
+}
+
+// Записать вступление журнала.
+//
+// В текстовом формате это строка вида:
+//
+//	# host=web-1 pid=1234 start=2006-01-02T15:04:05Z version=v1.0.0
+//
+// В формате JSON - отдельный объект с ключами: preamble, host, pid, start,
+// version, в формате logfmt - строка с такими же ключами. Для GELF и
+// собственных форматтеров вступление не пишется: оно не является
+// сообщением, и его нельзя записать в их формате.
+func (l *Logger) writePreamble(buf *[]byte) {
+	host, pid, version := processInfo()
+
+	var start = startTime
+	if l.UTC {
+		start = start.UTC()
+	}
+
+	switch l.formatter.(type) {
+	case nil, TextFormatter:
+	case LogfmtFormatter:
+		*buf = append(*buf, "preamble=true host="...)
+		appendLogfmtValue(buf, host)
+		*buf = append(*buf, " pid="...)
+		*buf = strconv.AppendInt(*buf, int64(pid), 10)
+		*buf = append(*buf, " start="...)
+		*buf = start.AppendFormat(*buf, time.RFC3339)
+		*buf = append(*buf, " version="...)
+		appendLogfmtValue(buf, version)
+		*buf = append(*buf, l.LineEnding...)
+		return
+	case JSONFormatter:
+		*buf = append(*buf, `{"preamble":true,"host":`...)
+		appendJSONString(buf, host)
+		*buf = append(*buf, `,"pid":`...)
+		*buf = strconv.AppendInt(*buf, int64(pid), 10)
+		*buf = append(*buf, `,"start":"`...)
+		*buf = start.AppendFormat(*buf, time.RFC3339)
+		*buf = append(*buf, `","version":`...)
+		appendJSONString(buf, version)
+		*buf = append(*buf, '}')
+		*buf = append(*buf, l.LineEnding...)
+		return
+	default:
+		return
+	}
+
+	*buf = append(*buf, "# host="...)
+	*buf = append(*buf, host...)
+	*buf = append(*buf, " pid="...)
+	*buf = strconv.AppendInt(*buf, int64(pid), 10)
+	*buf = append(*buf, " start="...)
+	*buf = start.AppendFormat(*buf, time.RFC3339)
+	*buf = append(*buf, " version="...)
+	*buf = append(*buf, version...)
+	*buf = append(*buf, l.LineEnding...)
+}
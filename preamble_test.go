@@ -0,0 +1,77 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWritePreamble(t *testing.T) {
+	var a, b bytes.Buffer
+	l := New(&a, TRACE)
+	l.Head = false
+	l.WritePreamble = true
+
+	l.Info("первое")
+	l.Info("второе")
+
+	lines := strings.Split(a.String(), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "# host=") || !strings.Contains(lines[0], " pid=") {
+		t.Fatalf("неверное вступление:\n%s", a.String())
+	}
+	if lines[1] != "первое" || lines[2] != "второе" {
+		t.Errorf("неверные сообщения:\n%s", a.String())
+	}
+
+	l.SetOutput(&b)
+	l.Info("третье")
+	if !strings.HasPrefix(b.String(), "# host=") {
+		t.Errorf("вступление не записано в новую цель вывода:\n%s", b.String())
+	}
+}
+
+func TestWritePreambleDerived(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Head = false
+	l.WritePreamble = true
+
+	l.With("id", 1).Info("a")
+	l.Named("db").Info("b")
+	l.Info("c")
+
+	if n := strings.Count(buf.String(), "# host="); n != 1 {
+		t.Errorf("вступление записано %d раз:\n%s", n, buf.String())
+	}
+
+	var other bytes.Buffer
+	c := l.Clone()
+	c.SetOutput(&other)
+	c.Info("d")
+	l.Info("e")
+	if !strings.HasPrefix(other.String(), "# host=") || strings.Count(buf.String(), "# host=") != 1 {
+		t.Errorf("неверное вступление после SetOutput:\n%s\n%s", other.String(), buf.String())
+	}
+}
+
+func TestWritePreambleFormat(t *testing.T) {
+	for _, c := range []struct {
+		format Format
+		prefix string
+		lines  int
+	}{
+		{FormatJSON, `{"preamble":true,"host":`, 2},
+		{FormatLogfmt, "preamble=true host=", 2},
+		{FormatGELF, `{"version":"1.1"`, 1},
+	} {
+		var buf bytes.Buffer
+		l := New(&buf, TRACE)
+		l.WritePreamble = true
+		l.SetFormat(c.format)
+		l.Info("a")
+
+		if !strings.HasPrefix(buf.String(), c.prefix) || strings.Count(buf.String(), "\n") != c.lines {
+			t.Errorf("%s: неверный вывод:\n%s", c.format, buf.String())
+		}
+	}
+}
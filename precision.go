@@ -0,0 +1,44 @@
+package log
+
+// TimePrecision описывает точность времени в заголовке сообщений.
+// См.: Logger.TimePrecision.
+type TimePrecision int
+
+// Точность времени в заголовке.
+const (
+	PrecisionSeconds      TimePrecision = iota // Секунды: HH:MM:SS.
+	PrecisionMilliseconds                      // Миллисекунды: HH:MM:SS.000.
+	PrecisionMicroseconds                      // Микросекунды: HH:MM:SS.000000.
+	PrecisionNanoseconds                       // Наносекунды: HH:MM:SS.000000000.
+)
+
+// Получить количество знаков дробной части секунд.
+func (p TimePrecision) digits() int {
+	switch p {
+	case PrecisionMilliseconds:
+		return 3
+	case PrecisionMicroseconds:
+		return 6
+	case PrecisionNanoseconds:
+		return 9
+	default:
+		return 0
+	}
+}
+
+// Получить действующую точность времени с учётом HeadMC.
+func (l *Logger) timePrecision() TimePrecision {
+	if l.TimePrecision == PrecisionSeconds && l.HeadMC {
+		return PrecisionMicroseconds
+	}
+	return l.TimePrecision
+}
+
+// Получить 10 в степени n.
+func pow10(n int) int {
+	var res = 1
+	for ; n > 0; n-- {
+		res *= 10
+	}
+	return res
+}
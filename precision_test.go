@@ -0,0 +1,33 @@
+package log
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimePrecision(t *testing.T) {
+	now := time.Date(2024, 5, 1, 7, 8, 9, 12345678, time.UTC)
+
+	for _, c := range []struct {
+		precision TimePrecision
+		mc        bool
+		want      string
+	}{
+		{PrecisionSeconds, false, "07:08:09"},
+		{PrecisionSeconds, true, "07:08:09.012345"},
+		{PrecisionMilliseconds, false, "07:08:09.012"},
+		{PrecisionMilliseconds, true, "07:08:09.012"},
+		{PrecisionMicroseconds, false, "07:08:09.012345"},
+		{PrecisionNanoseconds, false, "07:08:09.012345678"},
+	} {
+		l := NewTestLogger(nil)
+		l.TimePrecision = c.precision
+		l.HeadMC = c.mc
+
+		var b []byte
+		l.writeClock(&b, now)
+		if string(b) != c.want {
+			t.Errorf("%+v: время %q, ожидалось %q", c, b, c.want)
+		}
+	}
+}
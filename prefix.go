@@ -0,0 +1,54 @@
+package log
+
+// Prefix возвращает префикс сообщений логгера.
+func (l *Logger) Prefix() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.prefix
+}
+
+// SetPrefix устанавливает префикс сообщений логгера.
+//
+// Префикс выводится в текстовом формате после заголовка и перед текстом
+// каждого сообщения, даже если заголовок отключен. Это позволяет отметить
+// сообщения подсистемы и искать их с помощью grep:
+//
+//	auth := log.Default().Clone()
+//	auth.SetPrefix("[auth]")
+//
+// При включенной раскраске префикс выделяется тем же приглушённым цветом,
+// что и время в заголовке. Пустая строка отключает префикс.
+func (l *Logger) SetPrefix(prefix string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.prefix = prefix
+}
+
+// Named создаёт производный логгер с префиксом, дополненным именем
+// компонента через точку. Например, у логгера с префиксом "app" вызов
+// Named("db") вернёт логгер с префиксом "app.db". Если префикс исходного
+// логгера пуст, префиксом становится name.
+//
+// Так строится дерево логгеров компонентов с общей целью вывода:
+//
+//	db := l.Named("db")
+//	pool := db.Named("pool") // app.db.pool
+//
+// Производный логгер - это копия, см. Logger.Clone(), поэтому изменение
+// уровня или других настроек исходного логгера после создания на него не
+// распространяется.
+func (l *Logger) Named(name string) *Logger {
+	c := l.Clone()
+	if c.prefix != "" && name != "" {
+		c.prefix += "." + name
+	} else {
+		c.prefix += name
+	}
+	return c
+}
+
+// Named создаёт производный от дефолтного логгер с именем компонента
+// в префиксе. Подробнее смотрите: Logger.Named().
+func Named(name string) *Logger {
+	return std.Named(name)
+}
@@ -0,0 +1,43 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrefix(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.SetPrefix("[auth]")
+
+	l.Info("вход")
+	l.Head = false
+	l.Clone().Warn("выход")
+	l.SetPrefix("")
+	l.Info("без префикса")
+
+	if want := "[INFO] : [auth] вход\n[auth] выход\nбез префикса\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if l.Prefix() != "" {
+		t.Errorf("неверный префикс: %q", l.Prefix())
+	}
+}
+
+func TestNamed(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	db := l.Named("db")
+	l.SetPrefix("app")
+	l.SetLevel(ERROR)
+	db.Named("pool").Info("a")
+	l.Named("db").Named("").Error("b")
+
+	if want := "db.pool a\napp.db b\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
@@ -0,0 +1,59 @@
+package log
+
+import "os"
+
+// NewProduction создаёт логгер с настройками для промышленной эксплуатации.
+//
+// Применяемые настройки:
+//
+// - Вывод в os.Stderr.
+//
+// - Уровень: INFO.
+//
+// - Формат: FormatJSON. Поля выводятся в порядке их добавления.
+//
+// - Время в UTC: UTC = true.
+//
+// - Без раскраски: Color = false.
+//
+// - Без места вызова: HeadCaller = false.
+//
+// Остальные настройки имеют значения по умолчанию, как у log.New().
+func NewProduction() *Logger {
+	l := New(os.Stderr, INFO)
+	l.Color = false
+	l.UTC = true
+	l.SetFormat(FormatJSON)
+	return l
+}
+
+// NewDevelopment создаёт логгер с настройками для разработки.
+//
+// Применяемые настройки:
+//
+// - Вывод в os.Stderr.
+//
+// - Уровень: DEBUG.
+//
+// - Формат: FormatText.
+//
+// - Местное время: UTC = false.
+//
+// - Раскраска: Color = true.
+//
+// - Время с миллисекундами: TimePrecision = PrecisionMilliseconds.
+//
+// - Место вызова в заголовке: HeadCaller = true.
+//
+// - Стек вызовов для ошибок: StackTrace = true.
+//
+// Остальные настройки имеют значения по умолчанию, как у log.New().
+func NewDevelopment() *Logger {
+	l := New(os.Stderr, DEBUG)
+	l.Color = true
+	l.UTC = false
+	l.TimePrecision = PrecisionMilliseconds
+	l.HeadCaller = true
+	l.StackTrace = true
+	return l
+}
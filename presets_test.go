@@ -0,0 +1,26 @@
+package log
+
+import (
+	"os"
+	"testing"
+)
+
+func TestNewProduction(t *testing.T) {
+	l := NewProduction()
+	if l.Level() != INFO || l.Color || l.HeadCaller || !l.UTC || l.Output() != os.Stderr {
+		t.Errorf("неверные настройки: %v", l.settings())
+	}
+	if _, ok := l.formatter.(JSONFormatter); !ok {
+		t.Errorf("ожидался формат JSON: %T", l.formatter)
+	}
+}
+
+func TestNewDevelopment(t *testing.T) {
+	l := NewDevelopment()
+	if l.Level() != DEBUG || !l.Color || l.UTC || l.TimePrecision != PrecisionMilliseconds || !l.HeadCaller || !l.StackTrace {
+		t.Errorf("неверные настройки: %v", l.settings())
+	}
+	if l.formatter != nil {
+		t.Errorf("ожидался текстовый формат: %T", l.formatter)
+	}
+}
@@ -0,0 +1,66 @@
+package log
+
+import "time"
+
+// Окно ограничения частоты одного уровня.
+type rateWindow struct {
+	start   time.Time // Начало окна.
+	count   int       // Записано сообщений в окне.
+	dropped int       // Отброшено сообщений в окне.
+}
+
+// SetRateLimit ограничивает частоту записи: не более n сообщений каждого
+// уровня за интервал per.
+//
+// Сообщения сверх лимита отбрасываются до форматирования, поэтому поток
+// однотипных сообщений (например, ошибка в горячем цикле) почти ничего не
+// стоит и не забивает журнал. Когда интервал истекает, первое сообщение
+// нового окна предваряется итоговой строкой того же уровня с количеством
+// отброшенных, например: "... 4213 similar messages suppressed". Итоговая
+// строка пишется при следующем сообщении уровня, а не по таймеру. Общее
+// количество отброшенных сообщений возвращает Logger.SuppressedCount().
+//
+// Счёт ведётся отдельно для каждого уровня. Значение n <= 0 или per <= 0
+// отключает ограничение.
+//
+// По умолчанию: ограничения нет.
+func (l *Logger) SetRateLimit(n int, per time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if n <= 0 || per <= 0 {
+		n, per = 0, 0
+	}
+	l.rateN = n
+	l.ratePer = per
+	l.rateWin = [ERROR + 1]rateWindow{}
+}
+
+// SetRateLimit ограничивает частоту записи дефолтного логгера.
+// Подробнее смотрите: Logger.SetRateLimit().
+func SetRateLimit(n int, per time.Duration) {
+	std.SetRateLimit(n, per)
+}
+
+// Проверить, укладывается ли сообщение уровня level в лимит частоты.
+// Возвращает количество сообщений, отброшенных в истёкшем окне, если
+// сообщение открывает новое окно. Вызывается под мьютексом записи.
+func (l *Logger) rateAllow(level Level, now time.Time) (ok bool, dropped int) {
+	if level < TRACE || level > ERROR {
+		return true, 0
+	}
+
+	w := &l.rateWin[level]
+	if w.start.IsZero() || now.Sub(w.start) >= l.ratePer {
+		dropped = w.dropped
+		*w = rateWindow{start: now}
+	}
+	if w.count >= l.rateN {
+		w.dropped++
+		l.suppressed.Add(1)
+		return false, 0
+	}
+	w.count++
+
+	return true, dropped
+}
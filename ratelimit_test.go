@@ -0,0 +1,51 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// Значение, считающее свои преобразования в строку.
+type countingStringer struct{ n *int }
+
+func (s countingStringer) String() string {
+	*s.n++
+	return "x"
+}
+
+func TestSetRateLimit(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetRateLimit(2, 50*time.Millisecond)
+
+	var calls int
+	for i := 0; i < 10; i++ {
+		l.Warn(countingStringer{&calls})
+	}
+	l.Info("info")
+	if calls != 2 {
+		t.Errorf("сообщение сформировано %d раз, ожидалось 2", calls)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	l.Warn("снова")
+
+	want := "x\nx\ninfo\n... 8 similar messages suppressed\nснова\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if n := l.SuppressedCount(); n != 8 {
+		t.Errorf("подавлено %d сообщений, ожидалось 8", n)
+	}
+
+	buf.Reset()
+	l.SetRateLimit(0, 0)
+	for i := 0; i < 5; i++ {
+		l.Warn("w")
+	}
+	if n := bytes.Count(buf.Bytes(), []byte("\n")); n != 5 {
+		t.Errorf("после отключения записано %d сообщений, ожидалось 5", n)
+	}
+}
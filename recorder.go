@@ -0,0 +1,216 @@
+package log
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Record описывает одно сообщение журнала, перехваченное Recorder.
+//
+// В отличие от текста в выводе логгера, запись хранит сообщение без
+// заголовка и управляющих ANSI символов, что позволяет проверять его в
+// тестах без разбора отформатированной строки.
+type Record struct {
+	Level   Level     // Уровень важности сообщения.
+	Time    time.Time // Время записи сообщения.
+	Message string    // Текст сообщения без заголовка и раскраски.
+}
+
+// TB описывает минимальный набор методов *testing.T, необходимый для
+// проверок журнала.
+//
+// Позволяет использовать Expect и Recorder.AssertContains без импорта
+// пакета testing в рабочем коде.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// NewTestLogger создаёт логгер для использования в тестах.
+//
+// Логгер пишет в w все сообщения начиная с уровня TRACE и не использует
+// раскраску, поэтому его вывод можно сравнивать с эталоном без удаления
+// управляющих ANSI символов. Для уже раскрашенного потока используйте
+// log.StripColor(). Вывод остальных логгеров, в том числе в терминал,
+// это не затрагивает.
+func NewTestLogger(w io.Writer) *Logger {
+	l := New(w, TRACE)
+	l.Color = false
+	return l
+}
+
+// Recorder перехватывает сообщения логгера в виде структурированных записей.
+//
+// Перехват не влияет на обычный вывод логгера: сообщения по-прежнему
+// пишутся в его цель вывода. Попадают только сообщения, прошедшие фильтр
+// уровня важности. Перехватываются и сообщения производных логгеров
+// (With, Named, Clone), в том числе созданных до подключения
+// перехватчика.
+//
+// Создаётся с помощью конструктора: log.NewRecorder().
+type Recorder struct {
+	mu      sync.Mutex
+	logger  *Logger
+	entries []Entry
+}
+
+// NewRecorder создаёт перехватчик и подключает его к указанному логгеру.
+// Для отключения перехватчика вызовите: Recorder.Close().
+func NewRecorder(l *Logger) *Recorder {
+	r := &Recorder{logger: l}
+	l.addTap(r)
+	return r
+}
+
+// Close отключает перехватчик от логгера.
+// Ранее перехваченные записи остаются доступны.
+func (r *Recorder) Close() {
+	r.logger.removeTap(r)
+}
+
+// Records возвращает копию всех перехваченных записей в порядке их записи.
+func (r *Recorder) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]Record, len(r.entries))
+	for i, e := range r.entries {
+		res[i] = Record{Level: e.Level, Time: e.Time, Message: e.Message}
+	}
+	return res
+}
+
+// Entries возвращает копию всех перехваченных сообщений в порядке их записи.
+//
+// В отличие от Records(), сообщения содержат поля, добавленные с помощью
+// Logger.With(), и стек вызовов, если он был собран. Поле Entry.Logger
+// указывает на логгер, записавший сообщение: исходный или производный.
+func (r *Recorder) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Entry(nil), r.entries...)
+}
+
+// EntriesAt возвращает копию перехваченных сообщений указанного уровня
+// важности в порядке их записи.
+func (r *Recorder) EntriesAt(level Level) []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []Entry
+	for _, e := range r.entries {
+		if e.Level == level {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// Reset удаляет все перехваченные записи.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = nil
+}
+
+// Contains проверяет наличие записи с указанным уровнем важности,
+// текст которой содержит подстроку substr.
+func (r *Recorder) Contains(level Level, substr string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.entries {
+		if v.Level == level && strings.Contains(v.Message, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssertContains проверяет наличие записи с указанным уровнем важности,
+// текст которой содержит подстроку substr.
+//
+// Если запись не найдена, тест помечается проваленным, а в сообщение об
+// ошибке выводятся все перехваченные записи. Возвращает результат проверки.
+func (r *Recorder) AssertContains(t TB, level Level, substr string) bool {
+	t.Helper()
+	if r.Contains(level, substr) {
+		return true
+	}
+
+	t.Errorf("%s", diffRecords([]Record{{Level: level, Message: substr}}, r.Records()))
+	return false
+}
+
+// Добавить запись.
+func (r *Recorder) add(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, e)
+}
+
+// Expect выполняет функцию fn и проверяет, что за время её работы логгер
+// записал все ожидаемые сообщения.
+//
+// Ожидаемые записи должны встретиться в указанном порядке, но между ними
+// допускаются любые другие сообщения. Запись считается совпавшей, если
+// совпадает уровень важности и текст сообщения содержит want.Message.
+// Поле Time ожидаемых записей игнорируется.
+//
+// Если ожидания не выполнены, тест помечается проваленным, а в сообщение
+// об ошибке выводятся ненайденные и все перехваченные записи. Возвращает
+// результат проверки.
+func Expect(t TB, l *Logger, fn func(), want ...Record) bool {
+	t.Helper()
+
+	r := NewRecorder(l)
+	fn()
+	r.Close()
+
+	got := r.Records()
+	missing := want
+	for _, v := range got {
+		if len(missing) == 0 {
+			break
+		}
+		if v.Level == missing[0].Level && strings.Contains(v.Message, missing[0].Message) {
+			missing = missing[1:]
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	t.Errorf("%s", diffRecords(missing, got))
+	return false
+}
+
+// Построить описание расхождения ожидаемых и перехваченных записей.
+func diffRecords(missing, got []Record) string {
+	var b strings.Builder
+
+	b.WriteString("ожидаемые сообщения не найдены в журнале:\n")
+	for _, v := range missing {
+		b.WriteString("\t- ")
+		b.WriteString(levelLabel(v.Level))
+		b.WriteString(v.Message)
+		b.WriteByte('\n')
+	}
+
+	if len(got) == 0 {
+		b.WriteString("журнал пуст")
+		return b.String()
+	}
+
+	b.WriteString("перехваченные сообщения:\n")
+	for _, v := range got {
+		b.WriteString("\t  ")
+		b.WriteString(levelLabel(v.Level))
+		b.WriteString(v.Message)
+		b.WriteByte('\n')
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
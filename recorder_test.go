@@ -0,0 +1,124 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// Подменяет *testing.T для проверки провальных сценариев.
+type fakeTB struct {
+	errors []string
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestExpect(t *testing.T) {
+	l := New(io.Discard, TRACE)
+
+	Expect(t, l, func() {
+		l.Info("сервер запущен на порту ", 8080)
+		l.Debug("лишнее сообщение")
+		l.Warn("диск почти заполнен")
+	},
+		Record{Level: INFO, Message: "порту 8080"},
+		Record{Level: WARN, Message: "диск"},
+	)
+}
+
+func TestExpectFail(t *testing.T) {
+	l := New(io.Discard, TRACE)
+	ft := &fakeTB{}
+
+	ok := Expect(ft, l, func() {
+		l.Info("первое")
+	}, Record{Level: WARN, Message: "второе"})
+
+	if ok || len(ft.errors) != 1 {
+		t.Fatalf("ожидался провал проверки, получено: %v %v", ok, ft.errors)
+	}
+	if !strings.Contains(ft.errors[0], "[WARN]  второе") || !strings.Contains(ft.errors[0], "[INFO]  первое") {
+		t.Errorf("неполное описание расхождения:\n%s", ft.errors[0])
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	l := New(io.Discard, INFO)
+	r := NewRecorder(l)
+
+	l.Debug("отфильтровано уровнем")
+	l.Warn("предупреждение")
+	r.Close()
+	l.Warn("после отключения")
+
+	r.AssertContains(t, WARN, "предупреждение")
+	if n := len(r.Records()); n != 1 {
+		t.Errorf("перехвачено %d записей, ожидалась 1", n)
+	}
+
+	ft := &fakeTB{}
+	if r.AssertContains(ft, WARN, "после отключения") || len(ft.errors) != 1 {
+		t.Errorf("ожидался провал проверки")
+	}
+}
+
+func TestRecorderEntries(t *testing.T) {
+	l := New(io.Discard, TRACE).With("id", 7)
+	r := NewRecorder(l)
+	defer r.Close()
+
+	l.Info("первое")
+	l.Warn("второе")
+	l.Info("третье")
+
+	e := r.Entries()
+	if len(e) != 3 || e[1].Level != WARN || e[2].Message != "третье" {
+		t.Fatalf("неверные записи: %+v", e)
+	}
+	if len(e[0].Fields) != 1 || e[0].Fields[0] != (Field{"id", 7}) {
+		t.Errorf("неверные поля: %+v", e[0].Fields)
+	}
+	if e := r.EntriesAt(INFO); len(e) != 2 || e[0].Message != "первое" || e[1].Message != "третье" {
+		t.Errorf("неверная выборка по уровню: %+v", e)
+	}
+}
+
+func TestRecorderDerived(t *testing.T) {
+	l := New(io.Discard, TRACE)
+	before := l.Named("db")
+	r := NewRecorder(l)
+	after := l.With("id", 1)
+
+	before.Info("до подключения")
+	after.Clone().Warn("после подключения")
+
+	own := NewRecorder(after)
+	defer own.Close()
+	l.Info("исходный")
+
+	Expect(t, l, func() {
+		before.Error("внутри Expect")
+	}, Record{Level: ERROR, Message: "внутри Expect"})
+
+	r.Close()
+	after.Info("после отключения")
+
+	var got []string
+	for _, e := range r.Entries() {
+		got = append(got, e.Message)
+	}
+	if strings.Join(got, "|") != "до подключения|после подключения|исходный|внутри Expect" {
+		t.Errorf("неверные записи: %q", got)
+	}
+	if e := r.Entries(); e[0].Logger != before {
+		t.Errorf("Entry.Logger не указывает на производный логгер")
+	}
+	if n := len(own.Records()); n != 1 {
+		t.Errorf("перехватчик производного логгера получил %d записей, ожидалась 1", n)
+	}
+}
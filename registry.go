@@ -0,0 +1,77 @@
+package log
+
+import "sync"
+
+// Реестр именованных логгеров.
+var registry = struct {
+	mu      sync.Mutex
+	loggers map[string][]*Logger // Логгеры по имени подсистемы.
+	levels  map[string]Level     // Уровни, заданные для подсистем.
+}{
+	loggers: make(map[string][]*Logger),
+	levels:  make(map[string]Level),
+}
+
+// RegisterNamed регистрирует логгер в реестре подсистем по его имени: Logger.Name.
+//
+// Если для подсистемы уже был задан уровень через log.SetSubsystemLevel(),
+// он сразу применяется к регистрируемому логгеру. Логгеры без имени и
+// повторная регистрация игнорируются. Реестр хранит ссылку на логгер до
+// вызова log.UnregisterNamed().
+func RegisterNamed(l *Logger) {
+	if l.Name == "" {
+		return
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	for _, v := range registry.loggers[l.Name] {
+		if v == l {
+			return
+		}
+	}
+	registry.loggers[l.Name] = append(registry.loggers[l.Name], l)
+
+	if level, ok := registry.levels[l.Name]; ok {
+		l.SetLevel(level)
+	}
+}
+
+// UnregisterNamed удаляет логгер из реестра подсистем.
+func UnregisterNamed(l *Logger) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	list := registry.loggers[l.Name]
+	for i, v := range list {
+		if v == l {
+			list = append(list[:i:i], list[i+1:]...)
+			break
+		}
+	}
+
+	if len(list) == 0 {
+		delete(registry.loggers, l.Name)
+	} else {
+		registry.loggers[l.Name] = list
+	}
+}
+
+// SetSubsystemLevel устанавливает уровень важности логируемых сообщений
+// для всех зарегистрированных логгеров подсистемы name.
+//
+// Уровень запоминается и применяется также к логгерам, которые будут
+// зарегистрированы в этой подсистеме позже. Возвращает количество
+// логгеров, уровень которых был изменён.
+func SetSubsystemLevel(name string, level Level) int {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.levels[name] = level
+	for _, l := range registry.loggers[name] {
+		l.SetLevel(level)
+	}
+
+	return len(registry.loggers[name])
+}
@@ -0,0 +1,69 @@
+package log
+
+import (
+	"io"
+	"testing"
+)
+
+func TestSetSubsystemLevel(t *testing.T) {
+	db1 := New(io.Discard, INFO)
+	db1.Name = "db"
+	db2 := New(io.Discard, WARN)
+	db2.Name = "db"
+	web := New(io.Discard, INFO)
+	web.Name = "http"
+
+	RegisterNamed(db1)
+	RegisterNamed(db2)
+	RegisterNamed(web)
+	defer UnregisterNamed(db1)
+	defer UnregisterNamed(web)
+
+	if n := SetSubsystemLevel("db", DEBUG); n != 2 {
+		t.Errorf("изменено %d логгеров, ожидалось 2", n)
+	}
+	if db1.Level() != DEBUG || db2.Level() != DEBUG {
+		t.Error("уровень подсистемы не применён")
+	}
+	if web.Level() != INFO {
+		t.Error("уровень другой подсистемы не должен меняться")
+	}
+
+	UnregisterNamed(db2)
+	SetSubsystemLevel("db", ERROR)
+	if db2.Level() != DEBUG {
+		t.Error("удалённый из реестра логгер не должен меняться")
+	}
+
+	late := New(io.Discard, INFO)
+	late.Name = "db"
+	RegisterNamed(late)
+	defer UnregisterNamed(late)
+	if late.Level() != ERROR {
+		t.Error("уровень подсистемы не применён к новому логгеру")
+	}
+}
+
+func TestSetSubsystemLevelConcurrent(t *testing.T) {
+	l := New(io.Discard, INFO)
+	l.Name = "race"
+	RegisterNamed(l)
+	defer UnregisterNamed(l)
+
+	var done = make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			l.Debug("d")
+			l.Logf(INFO, "%d", i)
+			l.IsLevel(DEBUG)
+			l.DebugFunc(func() string { return "f" })
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		SetSubsystemLevel("race", Level(i%2)+DEBUG)
+		l.SetLevelString("warn")
+		l.WithLevel(TRACE)()
+	}
+	<-done
+}
@@ -0,0 +1,108 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// RingBuffer хранит в памяти последние строки журнала.
+//
+// Подключается к логгеру как дополнительная цель вывода и позволяет
+// посмотреть свежий журнал работающего сервиса без доступа к файлам.
+// Реализует http.Handler, поэтому его можно сразу отдать по HTTP:
+//
+//	ring := log.NewRingBuffer(1000)
+//	log.AddOutput(ring)
+//	http.Handle("/debug/log", ring)
+//
+// Каждая строка записи хранится отдельно, многострочные сообщения
+// занимают несколько строк. Когда буфер заполнен, новые строки вытесняют
+// самые старые. Раскраска, если она включена у логгера, сохраняется как
+// есть, её можно убрать функцией log.StripANSI().
+//
+// Безопасен для одновременного использования из нескольких горутин.
+// Создаётся с помощью конструктора: log.NewRingBuffer().
+type RingBuffer struct {
+	mu    sync.Mutex
+	lines []string // Кольцо строк.
+	next  int      // Индекс следующей записываемой строки.
+	full  bool     // Кольцо заполнено, next указывает на самую старую строку.
+}
+
+// NewRingBuffer создаёт буфер на size последних строк.
+// Значение size < 1 приравнивается к 1.
+func NewRingBuffer(size int) *RingBuffer {
+	return &RingBuffer{lines: make([]string, max(size, 1))}
+}
+
+// Write добавляет в буфер строки записи. Переводы строк не сохраняются.
+func (r *RingBuffer) Write(p []byte) (int, error) {
+	var n = len(p)
+	p = bytes.TrimSuffix(p, []byte{'\n'})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for {
+		var line = p
+		var i = bytes.IndexByte(p, '\n')
+		if i >= 0 {
+			line = p[:i]
+		}
+		r.lines[r.next] = string(bytes.TrimSuffix(line, []byte{'\r'}))
+		if r.next++; r.next == len(r.lines) {
+			r.next = 0
+			r.full = true
+		}
+		if i < 0 {
+			return n, nil
+		}
+		p = p[i+1:]
+	}
+}
+
+// Lines возвращает сохранённые строки, от старых к новым.
+func (r *RingBuffer) Lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]string(nil), r.lines[:r.next]...)
+	}
+
+	var res = make([]string, 0, len(r.lines))
+	res = append(res, r.lines[r.next:]...)
+	return append(res, r.lines[:r.next]...)
+}
+
+// WriteTo записывает сохранённые строки в w, от старых к новым, каждую
+// с переводом строки.
+func (r *RingBuffer) WriteTo(w io.Writer) (int64, error) {
+	var buf []byte
+	for _, v := range r.Lines() {
+		buf = append(buf, v...)
+		buf = append(buf, '\n')
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// Reset удаляет сохранённые строки.
+func (r *RingBuffer) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clear(r.lines)
+	r.next = 0
+	r.full = false
+}
+
+// ServeHTTP отдаёт сохранённые строки обычным текстом.
+func (r *RingBuffer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	r.WriteTo(w)
+}
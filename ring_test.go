@@ -0,0 +1,69 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRingBuffer(t *testing.T) {
+	ring := NewRingBuffer(3)
+	l := NewTestLogger(io.Discard)
+	l.Head = false
+	l.AddOutput(ring)
+
+	l.Info("a")
+	l.Info("b")
+	if got := ring.Lines(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("строки %q", got)
+	}
+
+	l.Info("c\nd")
+	l.Info("e")
+	if got := ring.Lines(); !reflect.DeepEqual(got, []string{"c", "d", "e"}) {
+		t.Errorf("после вытеснения строки %q", got)
+	}
+
+	var buf bytes.Buffer
+	ring.WriteTo(&buf)
+	if buf.String() != "c\nd\ne\n" {
+		t.Errorf("WriteTo записал %q", buf.String())
+	}
+
+	rec := httptest.NewRecorder()
+	ring.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/log", nil))
+	if rec.Body.String() != "c\nd\ne\n" || rec.Header().Get("Content-Type") != "text/plain; charset=utf-8" {
+		t.Errorf("неверный ответ: %q", rec.Body.String())
+	}
+
+	ring.Reset()
+	if got := ring.Lines(); len(got) != 0 {
+		t.Errorf("после Reset строки %q", got)
+	}
+}
+
+func TestRingBufferConcurrent(t *testing.T) {
+	ring := NewRingBuffer(50)
+	l := NewTestLogger(ring)
+	l.Head = false
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.Info("строка")
+				ring.Lines()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := ring.Lines(); len(got) != 50 || got[0] != "строка" {
+		t.Errorf("неверное содержимое: %d строк", len(got))
+	}
+}
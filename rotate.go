@@ -0,0 +1,201 @@
+package log
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// RotatingFile пишет журнал в файл с ротацией по размеру.
+//
+// Когда очередная запись не помещается в лимит размера, текущий файл
+// переименовывается в name.1, прежний name.1 - в name.2 и так далее до
+// указанного количества резервных копий. Самая старая копия удаляется.
+// Запись никогда не разрывается между файлами. Резервные копии можно
+// сжимать, см. RotatingFile.SetCompress().
+//
+// Безопасен для одновременного использования из нескольких горутин,
+// поэтому может использоваться как цель вывода логгера.
+// Создаётся с помощью конструктора: log.NewRotatingFile().
+type RotatingFile struct {
+	mu       sync.Mutex
+	path     string   // Путь к текущему файлу.
+	maxBytes int64    // Максимальный размер файла.
+	maxFiles int      // Количество резервных копий.
+	file     *os.File // Текущий файл.
+	size     int64    // Текущий размер файла.
+	compress bool     // Сжимать резервные копии.
+}
+
+// NewRotatingFile открывает файл журнала для дозаписи, создавая его при
+// необходимости.
+//
+// Параметры:
+//
+// - maxBytes - Максимальный размер файла в байтах. 0 - без ротации.
+//
+// - maxFiles - Количество хранимых резервных копий. При 0 файл при ротации
+// просто начинается заново.
+func NewRotatingFile(path string, maxBytes int64, maxFiles int) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:     path,
+		maxBytes: maxBytes,
+		maxFiles: maxFiles,
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Write записывает данные в файл, выполняя ротацию при превышении размера.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.maxBytes > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxBytes {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// SetCompress включает сжатие резервных копий в формате gzip.
+//
+// Если включено, при ротации текущий файл сжимается в name.1.gz, а
+// резервные копии именуются name.2.gz, name.3.gz и так далее. Сжатие
+// выполняется при ротации под блокировкой файла, поэтому запись на это
+// время приостанавливается. Копии, созданные до смены настройки, не
+// переименовываются и не удаляются.
+//
+// По умолчанию: false.
+func (f *RotatingFile) SetCompress(compress bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.compress = compress
+}
+
+// Rotate принудительно выполняет ротацию файла.
+func (f *RotatingFile) Rotate() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return os.ErrClosed
+	}
+
+	return f.rotate()
+}
+
+// Close закрывает файл. Повторный вызов ничего не делает.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
+
+// Открыть текущий файл.
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+// Сдвинуть резервные копии и начать новый файл.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+
+	if f.maxFiles > 0 {
+		os.Remove(f.backup(f.maxFiles))
+		for i := f.maxFiles - 1; i > 0; i-- {
+			os.Rename(f.backup(i), f.backup(i+1))
+		}
+		if f.compress {
+			if err := gzipFile(f.path, f.backup(1)); err != nil {
+				return err
+			}
+			if err := os.Remove(f.path); err != nil {
+				return err
+			}
+		} else if err := os.Rename(f.path, f.backup(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return err
+	}
+
+	return f.open()
+}
+
+// Получить путь к резервной копии с указанным номером.
+func (f *RotatingFile) backup(n int) string {
+	if f.compress {
+		return f.path + "." + strconv.Itoa(n) + ".gz"
+	}
+	return f.path + "." + strconv.Itoa(n)
+}
+
+// Сжать файл src в файл dst в формате gzip.
+// При ошибке недописанный файл dst удаляется.
+func gzipFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(out)
+	_, err = io.Copy(gz, in)
+	if e := gz.Close(); err == nil {
+		err = e
+	}
+	if e := out.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+
+	return err
+}
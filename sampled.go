@@ -0,0 +1,41 @@
+package log
+
+import "context"
+
+// WithSampled создаёт производный логгер с учётом решения о сэмплировании
+// трассировки запроса.
+//
+// Производный логгер добавляет к каждому сообщению поле sampled=true или
+// sampled=false. Для сэмплированных запросов его уровень понижается до
+// level, например до DEBUG, чтобы подробный журнал писался только для
+// трассировок, которые будут сохранены. Для остальных запросов уровень
+// исходного логгера не изменяется. Уровень никогда не повышается: если
+// исходный логгер уже пишет сообщения уровня level, он остаётся прежним.
+//
+// Исходный логгер не изменяется.
+func (l *Logger) WithSampled(sampled bool, level Level) *Logger {
+	c := l.With("sampled", sampled)
+	if sampled && level < c.level {
+		c.SetLevel(level)
+	}
+	return c
+}
+
+// WithSampled создаёт производный от дефолтного логгер с учётом решения о
+// сэмплировании. Подробнее смотрите: Logger.WithSampled().
+func WithSampled(sampled bool, level Level) *Logger {
+	return std.WithSampled(sampled, level)
+}
+
+// NewSampledContext возвращает копию контекста ctx, содержащую производный
+// логгер с учётом решения о сэмплировании трассировки.
+//
+// Производный логгер создаётся из логгера контекста с помощью
+// Logger.WithSampled() и извлекается обычным способом: log.FromContext().
+// Вызывайте при начале обработки запроса, когда решение о сэмплировании
+// уже известно:
+//
+//	ctx = log.NewSampledContext(ctx, span.IsSampled(), log.DEBUG)
+func NewSampledContext(ctx context.Context, sampled bool, level Level) context.Context {
+	return NewContext(ctx, FromContext(ctx).WithSampled(sampled, level))
+}
@@ -0,0 +1,34 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestNewSampledContext(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.SetLevel(INFO)
+	ctx := NewContext(context.Background(), l)
+
+	FromContext(NewSampledContext(ctx, true, DEBUG)).Debug("подробно")
+	FromContext(NewSampledContext(ctx, false, DEBUG)).Debug("скрыто")
+	FromContext(NewSampledContext(ctx, false, DEBUG)).Info("кратко")
+
+	want := "подробно sampled=true\nкратко sampled=false\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if l.Level() != INFO {
+		t.Errorf("уровень исходного логгера изменился: %v", l.Level())
+	}
+}
+
+func TestWithSampledNoRaise(t *testing.T) {
+	l := NewTestLogger(&bytes.Buffer{})
+	if c := l.WithSampled(true, DEBUG); c.Level() != TRACE {
+		t.Errorf("уровень не должен повышаться: %v", c.Level())
+	}
+}
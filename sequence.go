@@ -0,0 +1,16 @@
+package log
+
+// Sequence возвращает порядковый номер последнего записанного сообщения.
+//
+// Счётчик увеличивается для каждого сообщения, прошедшего проверку уровня
+// важности, заглушки и ограничения частоты, независимо от настройки
+// HeadSequence. До первой записи возвращает 0.
+func (l *Logger) Sequence() uint64 {
+	return l.seq.Load()
+}
+
+// Sequence возвращает порядковый номер последнего сообщения дефолтного
+// логгера. Подробнее смотрите: Logger.Sequence().
+func Sequence() uint64 {
+	return std.Sequence()
+}
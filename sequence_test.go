@@ -0,0 +1,27 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHeadSequence(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.HeadSequence = true
+	l.SetLevel(INFO)
+
+	l.Info("a")
+	l.Debug("пропущено")
+	l.Warn("b")
+
+	want := "#000001 [INFO] : a\n#000002 [WARN] : b\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if n := l.Sequence(); n != 2 {
+		t.Errorf("Sequence() = %d, ожидалось 2", n)
+	}
+}
@@ -0,0 +1,94 @@
+package log
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Получить значение поля в виде списка, если это срез или массив.
+// Срезы байт, ошибки и значения с методом String() списком не считаются.
+func listValue(v interface{}) (reflect.Value, bool) {
+	switch v.(type) {
+	case nil, []byte, error, fmt.Stringer:
+		return reflect.Value{}, false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		return rv, true
+	case reflect.Array:
+		return rv, true
+	}
+	return reflect.Value{}, false
+}
+
+// Записать список в текстовом виде.
+//
+// По умолчанию элементы-строки заключаются в кавычки, а список в
+// квадратные скобки: ["a","b","c"]. Если compact равен true, элементы
+// выводятся без кавычек и скобок: a,b,c. Вложенные списки всегда
+// заключаются в скобки.
+func appendTextList(buf *[]byte, rv reflect.Value, compact bool) {
+	if !compact {
+		*buf = append(*buf, '[')
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			*buf = append(*buf, ',')
+		}
+
+		var e = rv.Index(i)
+		if e.Kind() == reflect.Interface || e.Kind() == reflect.Pointer {
+			if e.IsNil() {
+				*buf = append(*buf, "null"...)
+				continue
+			}
+		}
+		var v = e.Interface()
+
+		if sub, ok := listValue(v); ok {
+			appendTextList(buf, sub, false)
+			continue
+		}
+
+		var s string
+		switch v := v.(type) {
+		case error:
+			s = v.Error()
+		case fmt.Stringer:
+			s = v.String()
+		default:
+			if e.Kind() == reflect.Interface {
+				e = e.Elem()
+			}
+			if e.Kind() != reflect.String {
+				*buf = fmt.Append(*buf, v)
+				continue
+			}
+			s = e.String()
+		}
+
+		if compact {
+			*buf = append(*buf, s...)
+		} else {
+			*buf = strconv.AppendQuote(*buf, s)
+		}
+	}
+
+	if !compact {
+		*buf = append(*buf, ']')
+	}
+}
+
+// Получить список в текстовом виде. См.: appendTextList().
+func textList(rv reflect.Value, compact bool) string {
+	var buf []byte
+	appendTextList(&buf, rv, compact)
+	return string(buf)
+}
@@ -0,0 +1,45 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestTextList(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+
+	l.With("tags", []string{"a", "b c", `d"`}).
+		With("ids", [3]int{1, 2, 3}).
+		With("nested", [][]string{{"x"}, {"y", "z"}}).
+		With("mixed", []interface{}{"s", 1, nil, &errorString{"e"}}).
+		With("empty", []string{}).
+		With("bytes", []byte("hi")).
+		Info("список")
+
+	want := `список tags=["a","b c","d\""] ids=[1,2,3] nested=[["x"],["y","z"]] mixed=["s",1,null,"e"] empty=[] bytes=[104 105]` + "\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	l.CompactSlices = true
+	l.With("tags", []string{"a", "b", "c"}).With("nested", [][]int{{1, 2}, {3}}).Info("список")
+	if want := "список tags=a,b,c nested=[1,2],[3]\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf.String(), want)
+	}
+}
+
+func TestJSONList(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.SetFormat(FormatJSON)
+	l.With("tags", []string{"a", "b"}).Info("список")
+
+	var v struct{ Tags []string }
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil || len(v.Tags) != 2 || v.Tags[1] != "b" {
+		t.Errorf("ожидался массив JSON: %s (%v)", buf.String(), err)
+	}
+}
@@ -0,0 +1,157 @@
+package log
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrSocketUnsupported возвращается конструктором log.NewSocketWriter() на
+// платформах без поддержки Unix сокетов.
+var ErrSocketUnsupported = errors.New("log: unix domain sockets are not supported on this platform")
+
+// Границы интервала между попытками подключения SocketWriter.
+const (
+	socketMinBackoff = 100 * time.Millisecond
+	socketMaxBackoff = 30 * time.Second
+)
+
+// SocketWriter пишет журнал в Unix сокет локального агента сбора логов.
+//
+// Подключение выполняется при первой записи. Если агент недоступен или
+// соединение разорвано, данные накапливаются в буфере ограниченного
+// размера, а повторное подключение выполняется при последующих записях с
+// экспоненциально растущим интервалом: от 100 мс до 30 с. После
+// восстановления соединения накопленные данные отправляются первыми.
+// Данные сверх размера буфера отбрасываются и учитываются в счётчике:
+// SocketWriter.Dropped().
+//
+// Запись никогда не возвращает ошибку и не блокирует логгер дольше одной
+// попытки подключения, поэтому недоступность агента не нарушает работу
+// приложения. Используйте как цель вывода логгера:
+//
+//	w, err := log.NewSocketWriter("/run/agent.sock", 1<<20)
+//	l := log.New(w, log.INFO)
+//
+// Безопасен для одновременного использования из нескольких горутин.
+type SocketWriter struct {
+	mu      sync.Mutex
+	path    string        // Путь к сокету.
+	max     int           // Максимальный размер буфера.
+	conn    net.Conn      // Текущее соединение.
+	buf     []byte        // Данные, ожидающие отправки.
+	backoff time.Duration // Текущий интервал между попытками подключения.
+	retry   time.Time     // Время следующей попытки подключения.
+	closed  bool          // Писатель закрыт.
+	dropped atomic.Uint64 // Отброшено байт.
+}
+
+// NewSocketWriter создаёт писатель в Unix сокет path.
+//
+// Параметр maxBuffer задаёт максимальный объём данных в байтах, который
+// накапливается во время недоступности агента. Если 0, данные при
+// недоступности агента отбрасываются.
+//
+// На платформах без Unix сокетов возвращает ошибку: ErrSocketUnsupported.
+func NewSocketWriter(path string, maxBuffer int) (*SocketWriter, error) {
+	if !socketSupported {
+		return nil, ErrSocketUnsupported
+	}
+
+	return &SocketWriter{
+		path:    path,
+		max:     maxBuffer,
+		backoff: socketMinBackoff,
+	}, nil
+}
+
+// Write отправляет данные в сокет или накапливает их в буфере.
+// Всегда возвращает len(p) и nil, кроме записи в закрытый писатель.
+func (w *SocketWriter) Write(p []byte) (int, error) {
+	var size = len(p)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, net.ErrClosed
+	}
+
+	if w.conn == nil && !time.Now().Before(w.retry) {
+		w.dial()
+	}
+
+	if w.conn != nil && len(w.buf) > 0 {
+		n, err := w.conn.Write(w.buf)
+		w.buf = w.buf[:copy(w.buf, w.buf[n:])]
+		if err != nil {
+			w.disconnect()
+		}
+	}
+
+	if w.conn != nil && len(w.buf) == 0 {
+		n, err := w.conn.Write(p)
+		if err == nil {
+			return size, nil
+		}
+		w.disconnect()
+		p = p[n:]
+	}
+
+	if len(w.buf)+len(p) > w.max {
+		w.dropped.Add(uint64(len(p)))
+	} else {
+		w.buf = append(w.buf, p...)
+	}
+
+	return size, nil
+}
+
+// Dropped возвращает количество байт, отброшенных из-за переполнения
+// буфера во время недоступности агента.
+func (w *SocketWriter) Dropped() uint64 {
+	return w.dropped.Load()
+}
+
+// Close закрывает соединение. Данные, оставшиеся в буфере, отбрасываются.
+// Повторный вызов ничего не делает.
+func (w *SocketWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	w.buf = nil
+
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
+
+// Подключиться к сокету.
+// При неудаче откладывает следующую попытку на интервал backoff.
+func (w *SocketWriter) dial() {
+	conn, err := net.DialTimeout("unix", w.path, time.Second)
+	if err != nil {
+		w.retry = time.Now().Add(w.backoff)
+		w.backoff = min(w.backoff*2, socketMaxBackoff)
+		return
+	}
+
+	w.conn = conn
+	w.backoff = socketMinBackoff
+}
+
+// Закрыть разорванное соединение.
+func (w *SocketWriter) disconnect() {
+	w.conn.Close()
+	w.conn = nil
+	w.retry = time.Now().Add(w.backoff)
+}
@@ -0,0 +1,89 @@
+package log
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSocketWriter(t *testing.T) {
+	dir, err := os.MkdirTemp("", "sock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "agent.sock")
+
+	w, err := NewSocketWriter(path, 16)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer w.Close()
+
+	// Агент недоступен: данные накапливаются в буфере.
+	w.Write([]byte("раз\n"))
+	w.Write([]byte("слишком длинная строка\n"))
+	if w.Dropped() == 0 {
+		t.Errorf("данные сверх буфера должны отбрасываться")
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	got := make(chan string)
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			got <- err.Error()
+			return
+		}
+		defer c.Close()
+		b, _ := io.ReadAll(c)
+		got <- string(b)
+	}()
+
+	time.Sleep(2 * socketMinBackoff)
+	w.Write([]byte("два\n"))
+	w.Close()
+
+	if s := <-got; s != "раз\nдва\n" {
+		t.Errorf("агент получил %q", s)
+	}
+}
+
+// Соединение, принимающее только часть данных и затем разрывающееся.
+type partialConn struct {
+	net.Conn
+	n int
+}
+
+func (c *partialConn) Write(p []byte) (int, error) {
+	return min(c.n, len(p)), io.ErrClosedPipe
+}
+
+func (c *partialConn) Close() error {
+	return nil
+}
+
+func TestSocketWriterPartial(t *testing.T) {
+	w, err := NewSocketWriter(filepath.Join(t.TempDir(), "agent.sock"), 64)
+	if err != nil {
+		t.Skip(err)
+	}
+	w.conn = &partialConn{n: 3}
+
+	p := []byte("строка\n")
+	if n, err := w.Write(p); n != len(p) || err != nil {
+		t.Errorf("Write вернул %d, %v, ожидалось %d, nil", n, err, len(p))
+	}
+	if string(w.buf) != string(p[3:]) {
+		t.Errorf("в буфере %q, ожидалось %q", w.buf, p[3:])
+	}
+	w.Close()
+}
@@ -0,0 +1,6 @@
+//go:build js || plan9 || wasip1
+
+package log
+
+// Платформа не поддерживает Unix сокеты.
+const socketSupported = false
@@ -0,0 +1,41 @@
+package log
+
+import "time"
+
+// Format возвращает строку, которую логгер записал бы для сообщения
+// уровня level, вместо её записи.
+//
+// Текст сообщения составляется из аргументов как в fmt.Sprint(). Строка
+// строится тем же форматтером и с теми же настройками заголовка,
+// раскраски и полей, что и при записи, вместе с завершающим переводом
+// строки. Пригодится для предпросмотра, тестов и уведомлений, например,
+// чтобы вернуть строку журнала в ответе HTTP. Цели вывода, хуки и
+// перехватчики не затрагиваются, уровень важности, заглушки и ограничение
+// частоты не проверяются. Порядковый номер при включенном HeadSequence
+// показывается следующий, но не расходуется, а отсчёт HeadDelta не
+// сбрасывается.
+func (l *Logger) Format(level Level, v ...interface{}) string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var e = l.newEntry(level, message{mode: 's', args: v}.String(), v, nil)
+	if l.HeadSequence {
+		e.Sequence = l.seq.Load() + 1
+	}
+	if last := l.last.Load(); l.HeadDelta && last != 0 {
+		e.Delta = time.Duration(e.Time.UnixNano() - last)
+	}
+
+	var buf = getBuffer()
+	defer putBuffer(buf)
+	l.format(buf, e)
+
+	return string(*buf)
+}
+
+// Sprint возвращает строку, которую дефолтный логгер записал бы для
+// сообщения. Имя Format в пакете занято типом формата вывода, поэтому
+// функция называется иначе. Подробнее смотрите: Logger.Format().
+func Sprint(level Level, v ...interface{}) string {
+	return std.Format(level, v...)
+}
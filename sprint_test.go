@@ -0,0 +1,28 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.HeadSequence = true
+	l.SetPrefix("app")
+	l.SetLevel(ERROR)
+
+	if s := l.Format(INFO, "порт ", 80); s != "#000001 [INFO] : app порт 80\n" {
+		t.Errorf("неверная строка: %q", s)
+	}
+	if buf.Len() != 0 || l.Sequence() != 0 {
+		t.Errorf("Format записал сообщение: %q", buf.String())
+	}
+
+	l.SetFormat(FormatJSON)
+	if s := l.Format(WARN, "x"); !bytes.Contains([]byte(s), []byte(`"level":"WARN","msg":"x"`)) {
+		t.Errorf("неверная строка JSON: %q", s)
+	}
+}
@@ -0,0 +1,207 @@
+package log
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Frame описывает один кадр стека вызовов.
+type Frame struct {
+	Func string // Полное имя функции, например: main.handle.
+	File string // Полный путь к файлу исходного кода.
+	Line int    // Номер строки в файле.
+}
+
+// Полное имя этого пакета для отсечения собственных кадров стека.
+var pkgPath = func() string {
+	pc, _, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name()
+	slash := strings.LastIndex(name, "/") + 1
+	return name[:slash+strings.Index(name[slash:], ".")]
+}()
+
+// Получить стек вызовов в месте записи сообщения.
+//
+// Кадры самого логгера отбрасываются, стек начинается с функции, вызвавшей
+// метод логгера. Тесты пакета считаются внешним кодом. Если depth больше
+// нуля, возвращается не более depth кадров.
+func callers(depth int) []Frame {
+	var pcs [64]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var res []Frame
+	var outside bool
+	for {
+		f, more := frames.Next()
+		if !outside && isInternal(f) {
+			if !more {
+				break
+			}
+			continue
+		}
+
+		outside = true
+		res = append(res, Frame{Func: f.Function, File: f.File, Line: f.Line})
+		if !more || (depth > 0 && len(res) >= depth) {
+			break
+		}
+	}
+
+	return res
+}
+
+// Получить место вызова логгера в виде: file.go:42.
+//
+// Кадры самого логгера отбрасываются, skip задаёт количество пропускаемых
+// после них внешних кадров. Если стек короче, возвращает пустую строку.
+func caller(skip int) string {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var outside bool
+	for {
+		f, more := frames.Next()
+		if outside || !isInternal(f) {
+			outside = true
+			if skip <= 0 {
+				return filepath.Base(f.File) + ":" + strconv.Itoa(f.Line)
+			}
+			skip--
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// Проверить принадлежность кадра стека самому логгеру.
+func isInternal(f runtime.Frame) bool {
+	if strings.HasSuffix(f.File, "_test.go") {
+		return false
+	}
+
+	name := f.Function
+	return strings.HasPrefix(name, pkgPath+".") && !strings.Contains(name[len(pkgPath)+1:], "/")
+}
+
+// Получить стек, сохранённый в ошибке среди аргументов сообщения.
+//
+// Поддерживаются ошибки с методом StackTrace(), возвращающим срез адресов
+// вызовов, как errors.StackTrace пакета github.com/pkg/errors. Цепочка
+// обёрток просматривается через errors.Unwrap(), и берётся самый глубокий
+// найденный стек - место возникновения ошибки. Если depth больше нуля,
+// возвращается не более depth кадров. Если стека нет, возвращает nil.
+func errorStack(args []interface{}, depth int) []Frame {
+	var pcs []uintptr
+	for _, v := range args {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+		for ; err != nil; err = errors.Unwrap(err) {
+			if s := stackOf(err); s != nil {
+				pcs = s
+			}
+		}
+	}
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	var res []Frame
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		res = append(res, Frame{Func: f.Function, File: f.File, Line: f.Line})
+		if !more || (depth > 0 && len(res) >= depth) {
+			break
+		}
+	}
+
+	return res
+}
+
+// Получить адреса вызовов из метода StackTrace() ошибки.
+// Тип результата определяется через reflect, чтобы не зависеть от пакета,
+// создавшего ошибку.
+func stackOf(err error) []uintptr {
+	m := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !m.IsValid() {
+		return nil
+	}
+
+	t := m.Type()
+	if t.NumIn() != 0 || t.NumOut() != 1 || t.Out(0).Kind() != reflect.Slice || t.Out(0).Elem().Kind() != reflect.Uintptr {
+		return nil
+	}
+
+	s := m.Call(nil)[0]
+	pcs := make([]uintptr, s.Len())
+	for i := range pcs {
+		pcs[i] = uintptr(s.Index(i).Uint())
+	}
+	return pcs
+}
+
+// Записать стек вызовов списком с отступом.
+// Каждый кадр начинается с новой строки, завершение последней строки
+// добавляет форматтер.
+func (l *Logger) writeStack(buf *[]byte, stack []Frame) {
+	for _, f := range stack {
+		*buf = append(*buf, '\n')
+		if l.Color {
+			*buf = append(*buf, colorHeader...)
+		}
+		*buf = append(*buf, "    "...)
+		*buf = append(*buf, f.Func...)
+		*buf = append(*buf, " ("...)
+		*buf = append(*buf, f.File...)
+		*buf = append(*buf, ':')
+		*buf = strconv.AppendInt(*buf, int64(f.Line), 10)
+		*buf = append(*buf, ')')
+		if l.Color {
+			*buf = append(*buf, colorClear...)
+		}
+	}
+}
+
+// Получить стек вызовов в виде текста, по одному кадру в строке.
+func stackText(stack []Frame) string {
+	var buf []byte
+	for i, f := range stack {
+		if i > 0 {
+			buf = append(buf, '\n')
+		}
+		buf = append(buf, f.Func...)
+		buf = append(buf, " ("...)
+		buf = append(buf, f.File...)
+		buf = append(buf, ':')
+		buf = strconv.AppendInt(buf, int64(f.Line), 10)
+		buf = append(buf, ')')
+	}
+	return string(buf)
+}
+
+// Записать стек вызовов в виде JSON массива.
+func appendJSONStack(buf *[]byte, stack []Frame) {
+	*buf = append(*buf, '[')
+	for i, f := range stack {
+		if i > 0 {
+			*buf = append(*buf, ',')
+		}
+		*buf = append(*buf, `{"func":`...)
+		appendJSONString(buf, f.Func)
+		*buf = append(*buf, `,"file":`...)
+		appendJSONString(buf, f.File)
+		*buf = append(*buf, `,"line":`...)
+		*buf = strconv.AppendInt(*buf, int64(f.Line), 10)
+		*buf = append(*buf, '}')
+	}
+	*buf = append(*buf, ']')
+}
@@ -0,0 +1,119 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestStackTraceText(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Color = false
+	l.Head = false
+	l.StackTrace = true
+	l.MaxStackDepth = 1
+
+	l.Warn("без стека")
+	l.write(ERROR, "со стеком")
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("ожидалось 3 строки:\n%s", buf.String())
+	}
+	if !strings.HasPrefix(lines[2], "    ") || !strings.Contains(lines[2], "TestStackTraceText (") || !strings.Contains(lines[2], "stack_test.go:") {
+		t.Errorf("неверный кадр стека: %q", lines[2])
+	}
+}
+
+func TestStackTraceJSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.SetFormat(FormatJSON)
+	l.StackTrace = true
+
+	l.write(ERROR, "со стеком")
+
+	var v struct {
+		Stack []struct {
+			Func string
+			File string
+			Line int
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+		t.Fatalf("некорректный JSON %q: %v", buf.String(), err)
+	}
+	if len(v.Stack) == 0 || !strings.HasSuffix(v.Stack[0].Func, ".TestStackTraceJSON") || v.Stack[0].Line == 0 {
+		t.Errorf("неверный стек: %+v", v.Stack)
+	}
+}
+
+// Обёртка приложения над логгером для проверки CallerSkip.
+func logWrapper(l *Logger, msg string) {
+	l.Info(msg)
+}
+
+func TestHeadCaller(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadLevel = false
+	l.HeadDate = false
+	l.HeadTime = false
+	l.HeadCaller = true
+
+	_, _, line, _ := runtime.Caller(0)
+	l.Info("a")
+	l.Infof("%s", "b")
+	l.WithField("k", 1).Info("c")
+	logWrapper(l, "d")
+	l.CallerSkip = 1
+	logWrapper(l, "e")
+
+	want := fmt.Sprintf("stack_test.go:%d: a\nstack_test.go:%d: b\nstack_test.go:%d: c k=1\nstack_test.go:%d: d\nstack_test.go:%d: e\n",
+		line+1, line+2, line+3, line-11, line+6)
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%s\nожидалось:\n%s", buf.String(), want)
+	}
+}
+
+// Ошибка со стеком, как в пакете github.com/pkg/errors.
+type stackError struct{ stack []stackFrame }
+
+type stackFrame uintptr
+
+func (e *stackError) Error() string            { return "сбой" }
+func (e *stackError) StackTrace() []stackFrame { return e.stack }
+
+func newStackError() error {
+	var pcs [8]uintptr
+	n := runtime.Callers(1, pcs[:])
+	e := &stackError{}
+	for _, pc := range pcs[:n] {
+		e.stack = append(e.stack, stackFrame(pc))
+	}
+	return e
+}
+
+func TestStackTraceError(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Color = false
+	l.Head = false
+	l.StackTrace = true
+	l.MaxStackDepth = 1
+
+	err := fmt.Errorf("обёртка: %w", newStackError())
+	l.Error("запрос: ", err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 || lines[0] != "запрос: обёртка: сбой" {
+		t.Fatalf("неверный вывод:\n%s", buf.String())
+	}
+	if !strings.Contains(lines[1], ".newStackError (") {
+		t.Errorf("выведен стек места записи, а не ошибки: %q", lines[1])
+	}
+}
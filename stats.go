@@ -0,0 +1,54 @@
+package log
+
+// Count возвращает количество сообщений уровня level, записанных в журнал.
+//
+// Учитываются только сообщения, прошедшие фильтр уровня, заглушение,
+// ограничение частоты и подавление повторов. Строка-сводка о подавленных
+// повторах считается отдельным сообщением. Счётчики атомарны, поэтому
+// метод безопасен при одновременной записи из нескольких горутин.
+// Для уровней вне диапазона TRACE - ERROR возвращает 0.
+func (l *Logger) Count(level Level) uint64 {
+	if level < TRACE || level > ERROR {
+		return 0
+	}
+	return l.counts[level].Load()
+}
+
+// Counts возвращает количество записанных сообщений по уровням важности.
+// См.: Logger.Count().
+func (l *Logger) Counts() map[Level]uint64 {
+	res := make(map[Level]uint64, len(l.counts))
+	for level := range l.counts {
+		res[Level(level)] = l.counts[level].Load()
+	}
+	return res
+}
+
+// ResetCounts обнуляет счётчики записанных сообщений.
+func (l *Logger) ResetCounts() {
+	for level := range l.counts {
+		l.counts[level].Store(0)
+	}
+}
+
+// ByteStats возвращает количество байт, записанных в журнал, по уровням
+// важности.
+//
+// Учитывается фактически записанный объём по значению, которое вернула
+// цель вывода, включая заголовки, раскраску и вступление журнала.
+// Вместе с количеством сообщений это позволяет оценить, какие уровни
+// определяют объём хранимого журнала.
+func (l *Logger) ByteStats() map[Level]uint64 {
+	res := make(map[Level]uint64, len(l.bytes))
+	for level := range l.bytes {
+		res[Level(level)] = l.bytes[level].Load()
+	}
+	return res
+}
+
+// ResetByteStats обнуляет счётчики записанных байт.
+func (l *Logger) ResetByteStats() {
+	for level := range l.bytes {
+		l.bytes[level].Store(0)
+	}
+}
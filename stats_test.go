@@ -0,0 +1,63 @@
+package log
+
+import (
+	"io"
+	"sync"
+	"testing"
+)
+
+func TestByteStats(t *testing.T) {
+	l := NewTestLogger(io.Discard)
+	l.Head = false
+
+	l.Info("12345")
+	l.Info("1")
+	l.Warn("123")
+
+	stats := l.ByteStats()
+	if stats[INFO] != 8 || stats[WARN] != 4 || stats[DEBUG] != 0 || len(stats) != 5 {
+		t.Errorf("неверная статистика: %v", stats)
+	}
+
+	l.ResetByteStats()
+	if stats := l.ByteStats(); stats[INFO] != 0 || stats[WARN] != 0 {
+		t.Errorf("статистика не сброшена: %v", stats)
+	}
+}
+
+func TestCount(t *testing.T) {
+	l := NewTestLogger(io.Discard)
+	l.SetLevel(INFO)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.Warn("w")
+				l.Debug("d")
+			}
+		}()
+	}
+	wg.Wait()
+	l.Error("e")
+
+	if n := l.Count(WARN); n != 1000 {
+		t.Errorf("WARN: %d, ожидалось 1000", n)
+	}
+	if n := l.Count(DEBUG); n != 0 {
+		t.Errorf("DEBUG: %d, ожидалось 0", n)
+	}
+	if c := l.Counts(); c[ERROR] != 1 || c[WARN] != 1000 || len(c) != 5 {
+		t.Errorf("неверные счётчики: %v", c)
+	}
+	if n := l.Count(Level(42)); n != 0 {
+		t.Errorf("неизвестный уровень: %d", n)
+	}
+
+	l.ResetCounts()
+	if n := l.Count(WARN); n != 0 {
+		t.Errorf("счётчики не сброшены: %d", n)
+	}
+}
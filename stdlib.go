@@ -0,0 +1,156 @@
+package log
+
+import "io"
+
+// Print выводит информационное сообщение, как Logger.Info().
+// Аналог log.Print() стандартной библиотеки.
+func (l *Logger) Print(v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.write(INFO, v...)
+}
+
+// Printf выводит форматированное информационное сообщение, как Logger.Infof().
+// Аналог log.Printf() стандартной библиотеки.
+func (l *Logger) Printf(format string, v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.writef(INFO, format, v...)
+}
+
+// Println выводит информационное сообщение, как Logger.Infoln().
+// Аналог log.Println() стандартной библиотеки.
+func (l *Logger) Println(v ...interface{}) {
+	if !l.EnabledFast(INFO) {
+		return
+	}
+
+	l.writeln(INFO, v...)
+}
+
+// Print выводит информационное сообщение, как log.Info().
+// Аналог log.Print() стандартной библиотеки.
+func Print(v ...interface{}) {
+	std.Print(v...)
+}
+
+// Printf выводит форматированное информационное сообщение, как log.Infof().
+// Аналог log.Printf() стандартной библиотеки.
+func Printf(format string, v ...interface{}) {
+	std.Printf(format, v...)
+}
+
+// Println выводит информационное сообщение, как log.Infoln().
+// Аналог log.Println() стандартной библиотеки.
+func Println(v ...interface{}) {
+	std.Println(v...)
+}
+
+// SetOutput устанавливает цель вывода дефолтного логгера.
+// Подробнее смотрите: Logger.SetOutput().
+func SetOutput(w io.Writer) {
+	std.SetOutput(w)
+}
+
+// SetPrefix устанавливает префикс сообщений дефолтного логгера.
+// Подробнее смотрите: Logger.SetPrefix().
+func SetPrefix(prefix string) {
+	std.SetPrefix(prefix)
+}
+
+// Prefix возвращает префикс сообщений дефолтного логгера.
+func Prefix() string {
+	return std.Prefix()
+}
+
+// Флаги заголовка, совместимые со стандартной библиотекой.
+// Используются в Logger.SetFlags() и имеют те же значения, что и
+// одноимённые константы пакета log стандартной библиотеки.
+const (
+	Ldate         = 1 << iota     // Дата: HeadDate.
+	Ltime                         // Время: HeadTime.
+	Lmicroseconds                 // Микросекунды: HeadTime и TimePrecision.
+	_                             // Llongfile не поддерживается.
+	Lshortfile                    // Место вызова: HeadCaller.
+	LUTC                          // Время в UTC: UTC.
+	LstdFlags     = Ldate | Ltime // Значение по умолчанию в стандартной библиотеке.
+)
+
+// SetFlags настраивает заголовок сообщений флагами стандартной библиотеки.
+//
+// Флаги устанавливают соответствующие настройки логгера: Ldate - HeadDate,
+// Ltime - HeadTime, Lmicroseconds - HeadTime, HeadMC и TimePrecision
+// PrecisionMicroseconds, Lshortfile - HeadCaller, LUTC - UTC. Настройки,
+// флаги которых не указаны, отключаются, а TimePrecision без
+// Lmicroseconds сбрасывается до PrecisionSeconds, поэтому Flags() всегда
+// возвращает установленные флаги. Источником истины остаются поля логгера, поэтому их можно
+// менять и после вызова. Остальные настройки заголовка не изменяются.
+func (l *Logger) SetFlags(flag int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.HeadDate = flag&Ldate != 0
+	l.HeadTime = flag&(Ltime|Lmicroseconds) != 0
+	l.HeadMC = flag&Lmicroseconds != 0
+	l.TimePrecision = PrecisionSeconds
+	if l.HeadMC {
+		l.TimePrecision = PrecisionMicroseconds
+	}
+	l.HeadCaller = flag&Lshortfile != 0
+	l.UTC = flag&LUTC != 0
+}
+
+// Flags возвращает флаги стандартной библиотеки, соответствующие текущим
+// настройкам заголовка. Подробнее смотрите: Logger.SetFlags().
+func (l *Logger) Flags() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var flag int
+	if l.HeadDate {
+		flag |= Ldate
+	}
+	if l.HeadTime {
+		flag |= Ltime
+		if l.timePrecision() == PrecisionMicroseconds {
+			flag |= Lmicroseconds
+		}
+	}
+	if l.HeadCaller {
+		flag |= Lshortfile
+	}
+	if l.UTC {
+		flag |= LUTC
+	}
+	return flag
+}
+
+// SetFlags настраивает заголовок дефолтного логгера флагами стандартной
+// библиотеки. Подробнее смотрите: Logger.SetFlags().
+func SetFlags(flag int) {
+	std.SetFlags(flag)
+}
+
+// Flags возвращает флаги стандартной библиотеки, соответствующие
+// настройкам заголовка дефолтного логгера.
+func Flags() int {
+	return std.Flags()
+}
+
+// Проверка совместимости с интерфейсом, который часто используют
+// библиотеки, принимающие стандартный логгер.
+var _ interface {
+	Print(v ...interface{})
+	Printf(format string, v ...interface{})
+	Println(v ...interface{})
+	Fatal(v ...interface{})
+	Fatalf(format string, v ...interface{})
+	Fatalln(v ...interface{})
+	Panic(v ...interface{})
+	Panicf(format string, v ...interface{})
+	Panicln(v ...interface{})
+} = (*Logger)(nil)
@@ -0,0 +1,67 @@
+package log
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestStdlibShims(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	r := NewRecorder(l)
+	defer r.Close()
+
+	l.Print("a", 1)
+	l.Printf("b%d", 2)
+	l.Println("c", 3)
+
+	if want := "a1\nb2\nc 3\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+	if n := len(r.EntriesAt(INFO)); n != 3 {
+		t.Errorf("сообщения должны иметь уровень INFO: %d", n)
+	}
+}
+
+func TestSetFlags(t *testing.T) {
+	l := NewTestLogger(&bytes.Buffer{})
+	if f := l.Flags(); f != LstdFlags|LUTC {
+		t.Errorf("флаги по умолчанию %b, ожидалось %b", f, LstdFlags|LUTC)
+	}
+
+	l.SetFlags(Ltime | Lmicroseconds | Lshortfile)
+	if l.HeadDate || !l.HeadTime || l.TimePrecision != PrecisionMicroseconds || !l.HeadCaller || l.UTC {
+		t.Errorf("неверные настройки заголовка после SetFlags()")
+	}
+	if f := l.Flags(); f != Ltime|Lmicroseconds|Lshortfile {
+		t.Errorf("Flags() = %b, ожидалось %b", f, Ltime|Lmicroseconds|Lshortfile)
+	}
+
+	l.TimePrecision = PrecisionMilliseconds
+	if f := l.Flags(); f != Ltime|Lshortfile {
+		t.Errorf("Flags() после изменения поля = %b", f)
+	}
+}
+
+func TestSetFlagsPrecision(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.TimePrecision = PrecisionMilliseconds
+	l.HeadLevel = false
+
+	for _, flag := range []int{Ltime, LstdFlags | Lmicroseconds, Ltime | LUTC} {
+		l.SetFlags(flag)
+		if f := l.Flags(); f != flag {
+			t.Errorf("Flags() = %b, ожидалось %b", f, flag)
+		}
+	}
+
+	buf.Reset()
+	l.SetFlags(Ltime)
+	l.Info("a")
+	if !regexp.MustCompile(`^\d\d:\d\d:\d\d: a\n$`).MatchString(buf.String()) {
+		t.Errorf("неверная точность времени: %q", buf.String())
+	}
+}
@@ -0,0 +1,195 @@
+package log
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Пути к сокету локального демона syslog.
+var syslogPaths = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// Формат времени в сообщениях RFC 5424.
+const syslogTime = "2006-01-02T15:04:05.000000Z07:00"
+
+// Категория сообщений syslog: пользовательские программы (LOG_USER).
+const syslogFacility = 1
+
+// SyslogWriter пишет журнал в syslog в формате RFC 5424.
+//
+// Реализует LevelWriter, поэтому уровень важности каждого сообщения
+// логгера передаётся в syslog как severity: ERROR - Err, WARN - Warning,
+// INFO - Info, DEBUG и TRACE - Debug. Сообщения записываются в категорию
+// LOG_USER с указанием времени, имени узла, имени приложения и номера
+// процесса. Время и уровень уже содержатся в заголовке syslog, поэтому
+// заголовок логгера обычно отключают:
+//
+//	w, err := log.NewSyslogWriter("", "", "myapp")
+//	l := log.New(w, log.INFO)
+//	l.Head = false
+//
+// При ошибке записи выполняется одна попытка переподключения.
+// Безопасен для одновременного использования из нескольких горутин.
+type SyslogWriter struct {
+	mu       sync.Mutex
+	network  string   // Сеть подключения, пусто - локальный демон.
+	addr     string   // Адрес подключения.
+	app      string   // Имя приложения.
+	hostname string   // Имя узла.
+	pid      string   // Номер процесса.
+	conn     net.Conn // Текущее соединение.
+	stream   bool     // Потоковое соединение: сообщения нужно разделять.
+	closed   bool     // Писатель закрыт.
+}
+
+// NewSyslogWriter подключается к syslog.
+//
+// Если network пуст, выполняется подключение к локальному демону через
+// Unix сокет /dev/log (или /var/run/syslog, /var/run/log). Иначе
+// подключение выполняется к удалённому серверу: network - "udp" или
+// "tcp", addr - адрес сервера, например: "logs.example.com:514".
+// Параметр app задаёт имя приложения в сообщениях, по умолчанию - имя
+// исполняемого файла.
+func NewSyslogWriter(network, addr, app string) (*SyslogWriter, error) {
+	if app == "" {
+		app = filepath.Base(os.Args[0])
+	}
+	host, pid, _ := processInfo()
+	if host == "" {
+		host = "-"
+	}
+
+	w := &SyslogWriter{
+		network:  network,
+		addr:     addr,
+		app:      app,
+		hostname: host,
+		pid:      strconv.Itoa(pid),
+	}
+
+	if err := w.dial(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Write отправляет сообщение с уровнем важности INFO.
+func (w *SyslogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(INFO, p)
+}
+
+// WriteLevel отправляет сообщение с уровнем важности level.
+// Завершающий перевод строки в сообщение не включается.
+func (w *SyslogWriter) WriteLevel(level Level, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, net.ErrClosed
+	}
+
+	var msg = w.format(level, time.Now(), p)
+	if w.conn != nil {
+		if _, err := w.conn.Write(msg); err == nil {
+			return len(p), nil
+		}
+		w.conn.Close()
+		w.conn = nil
+	}
+
+	if err := w.dial(); err != nil {
+		return 0, err
+	}
+	if _, err := w.conn.Write(msg); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close закрывает соединение. Повторный вызов ничего не делает.
+func (w *SyslogWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
+
+// Подключиться к syslog.
+func (w *SyslogWriter) dial() error {
+	if w.network != "" {
+		conn, err := net.DialTimeout(w.network, w.addr, 5*time.Second)
+		if err != nil {
+			return err
+		}
+		w.conn = conn
+		w.stream = w.network != "udp" && w.network != "udp4" && w.network != "udp6" && w.network != "unixgram"
+		return nil
+	}
+
+	for _, path := range syslogPaths {
+		for _, network := range []string{"unixgram", "unix"} {
+			conn, err := net.DialTimeout(network, path, time.Second)
+			if err == nil {
+				w.conn = conn
+				w.stream = network == "unix"
+				return nil
+			}
+		}
+	}
+
+	return errors.New("log: local syslog is not available")
+}
+
+// Получить сообщение в формате RFC 5424:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID SD MSG.
+//
+// В потоковых соединениях сообщение предваряется длиной (RFC 6587), а для
+// локального демона завершается переводом строки. Завершающий перевод
+// строки записи, в том числе "\r\n" (Logger.LineEnding), отбрасывается.
+func (w *SyslogWriter) format(level Level, now time.Time, p []byte) []byte {
+	if n := len(p); n > 0 && p[n-1] == '\n' {
+		p = p[:n-1]
+	}
+	if n := len(p); n > 0 && p[n-1] == '\r' {
+		p = p[:n-1]
+	}
+
+	var msg = make([]byte, 0, len(p)+96)
+	msg = append(msg, '<')
+	msg = strconv.AppendInt(msg, int64(syslogFacility*8+syslogSeverity(level)), 10)
+	msg = append(msg, ">1 "...)
+	msg = now.AppendFormat(msg, syslogTime)
+	msg = append(msg, ' ')
+	msg = append(msg, w.hostname...)
+	msg = append(msg, ' ')
+	msg = append(msg, w.app...)
+	msg = append(msg, ' ')
+	msg = append(msg, w.pid...)
+	msg = append(msg, " - - "...)
+	msg = append(msg, p...)
+
+	switch {
+	case w.stream && w.network == "":
+		msg = append(msg, '\n')
+	case w.stream:
+		msg = append(strconv.AppendInt(nil, int64(len(msg)), 10), append([]byte{' '}, msg...)...)
+	}
+
+	return msg
+}
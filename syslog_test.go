@@ -0,0 +1,72 @@
+package log
+
+import (
+	"bufio"
+	"net"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSyslogWriterUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer pc.Close()
+
+	w, err := NewSyslogWriter("udp", pc.LocalAddr().String(), "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	l := NewTestLogger(w)
+	l.Head = false
+	l.Warn("диск заполнен")
+	l.LineEnding = "\r\n"
+	l.Warn("диск заполнен")
+
+	re := regexp.MustCompile(`^<12>1 \d{4}-\d\d-\d\dT\S+ \S+ app \d+ - - диск заполнен$`)
+	for i := 0; i < 2; i++ {
+		var b [1024]byte
+		pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := pc.ReadFrom(b[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !re.Match(b[:n]) {
+			t.Errorf("неверное сообщение: %q", b[:n])
+		}
+	}
+}
+
+func TestSyslogWriterTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ln.Close()
+
+	var lines = make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s, _ := bufio.NewReader(conn).ReadString('>')
+		lines <- s
+	}()
+
+	w, err := NewSyslogWriter("tcp", ln.Addr().String(), "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	w.WriteLevel(ERROR, []byte("сбой\n"))
+	if s := <-lines; !regexp.MustCompile(`^\d+ <11>$`).MatchString(s) {
+		t.Errorf("неверное начало сообщения: %q", s)
+	}
+}
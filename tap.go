@@ -0,0 +1,75 @@
+package log
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Перехватчик сообщений журнала.
+//
+// Получает каждое записанное сообщение в виде Entry: уровень важности,
+// время, текст без заголовка и раскраски и поля. Вызывается под мьютексом
+// записи логгера, написавшего сообщение, поэтому не должен обращаться к
+// логгеру и блокироваться надолго. Перехватчик получает и сообщения
+// производных логгеров, записывающих их одновременно, поэтому должен
+// защищать своё состояние сам.
+type tap interface {
+	add(e Entry)
+}
+
+// Перехватчики логгера.
+//
+// Производный логгер (With, Named, Clone) получает собственный набор,
+// связанный с набором исходного логгера, поэтому перехватчики исходного
+// логгера видят и сообщения производных, в том числе созданных до
+// подключения перехватчика. Перехватчики производного логгера сообщений
+// исходного не видят.
+type tapSet struct {
+	mu     sync.Mutex
+	parent *tapSet               // Набор исходного логгера.
+	list   atomic.Pointer[[]tap] // Подключенные перехватчики. Заменяется целиком.
+}
+
+// Передать сообщение перехватчикам набора и всех исходных наборов.
+func (s *tapSet) add(e Entry) {
+	for ; s != nil; s = s.parent {
+		if list := s.list.Load(); list != nil {
+			for _, t := range *list {
+				t.add(e)
+			}
+		}
+	}
+}
+
+// Подключить перехватчик.
+func (l *Logger) addTap(t tap) {
+	var s = l.taps
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var list []tap
+	if v := s.list.Load(); v != nil {
+		list = *v
+	}
+	list = append(list[:len(list):len(list)], t)
+	s.list.Store(&list)
+}
+
+// Отключить перехватчик.
+func (l *Logger) removeTap(t tap) {
+	var s = l.taps
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var v = s.list.Load()
+	if v == nil {
+		return
+	}
+	for i, x := range *v {
+		if x == t {
+			var list = append((*v)[:i:i], (*v)[i+1:]...)
+			s.list.Store(&list)
+			return
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package log
+
+import (
+	"io"
+	"os"
+)
+
+// Проверить, является ли цель вывода терминалом.
+// Терминалом считается только *os.File, открытый на символьное устройство,
+// в том числе через AsyncWriter.
+func isTerminal(w io.Writer) bool {
+	if a, ok := w.(*AsyncWriter); ok {
+		w = a.out
+	}
+
+	f, ok := w.(*os.File)
+	if !ok || f == nil {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,38 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAutoColor(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "app.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if isTerminal(f) || isTerminal(&bytes.Buffer{}) || isTerminal((*os.File)(nil)) {
+		t.Errorf("файл и буфер не являются терминалом")
+	}
+
+	l := New(f, INFO)
+	if l.Color {
+		t.Errorf("раскраска должна отключаться для файла")
+	}
+
+	l.Color = true
+	l.SetOutput(&bytes.Buffer{})
+	if l.Color {
+		t.Errorf("раскраска должна определяться заново при смене цели вывода")
+	}
+
+	l.AutoColor = false
+	l.Color = true
+	l.SetOutput(f)
+	if !l.Color {
+		t.Errorf("принудительная раскраска не должна сбрасываться")
+	}
+}
@@ -0,0 +1,112 @@
+package log
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Многоточие, обозначающее обрезанный текст.
+const ellipsis = "…"
+
+// Метка обрезанного текста сообщения. См.: Logger.MaxBodyLen.
+const truncMarker = ellipsis + "[truncated]"
+
+// Обрезать строку до max символов, добавив многоточие.
+// Многобайтовые символы UTF-8 не разрываются.
+func truncate(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+
+	var n int
+	for i := range s {
+		if n == max {
+			return s[:i] + ellipsis
+		}
+		n++
+	}
+
+	return s
+}
+
+// Обрезать строку так, чтобы вместе с меткой truncMarker она уместилась
+// в max байт. Многобайтовые символы UTF-8 не разрываются.
+func truncateBytes(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+
+	var n = max - len(truncMarker)
+	if n < 0 {
+		n = 0
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + truncMarker
+}
+
+// Получить поля с обрезанными по Logger.MaxFieldLen значениями.
+// Если обрезать нечего, возвращается исходный срез.
+func (l *Logger) limitFields(fields []Field) []Field {
+	if l.MaxFieldLen <= 0 || len(fields) == 0 {
+		return fields
+	}
+
+	var res []Field
+	for i, f := range fields {
+		v, ok := limitValue(f.Value, l.MaxFieldLen, l.CompactSlices)
+		if !ok {
+			continue
+		}
+		if res == nil {
+			res = append([]Field(nil), fields...)
+		}
+		res[i].Value = v
+	}
+
+	if res == nil {
+		return fields
+	}
+	return res
+}
+
+// Обрезать значение поля.
+// Списки обрезаются в текстовом виде, заданном compact.
+// Возвращает false, если значение обрезать не требуется.
+func limitValue(v interface{}, max int, compact bool) (interface{}, bool) {
+	var s string
+	switch v := v.(type) {
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v, false
+	case string:
+		s = v
+	case error:
+		if isNil(v) {
+			return v, false
+		}
+		s = v.Error()
+	case fmt.Stringer:
+		if isNil(v) {
+			return v, false
+		}
+		s = v.String()
+	default:
+		var buf []byte
+		appendJSONValue(&buf, v)
+		if utf8.RuneCount(buf) <= max {
+			return v, false
+		}
+		if rv, ok := listValue(v); ok {
+			s = textList(rv, compact)
+		} else {
+			s = fmt.Sprint(v)
+		}
+	}
+
+	if utf8.RuneCountInString(s) <= max {
+		return v, false
+	}
+	return truncate(s, max), true
+}
@@ -0,0 +1,86 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	for _, c := range []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"abc", 0, "abc"},
+		{"abc", 3, "abc"},
+		{"abcdef", 3, "abc…"},
+		{"привет", 6, "привет"},
+		{"привет", 3, "при…"},
+		{"日本語テキスト", 2, "日本…"},
+	} {
+		if got := truncate(c.in, c.max); got != c.want {
+			t.Errorf("truncate(%q, %d) = %q, ожидалось %q", c.in, c.max, got, c.want)
+		}
+	}
+}
+
+func TestMaxFieldLen(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.MaxFieldLen = 4
+
+	long := strings.Repeat("я", 100)
+	l.With("s", long).
+		With("short", "ok").
+		With("n", 1234567890).
+		With("err", errors.New("долгая ошибка")).
+		With("list", []int{1, 2, 3, 4, 5}).
+		Info(long)
+
+	want := long + " s=яяяя… short=ok n=1234567890 err=долг… list=[1,2…\n"
+	if buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	for _, c := range []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"abc", 0, "abc"},
+		{"abc", 3, "abc"},
+		{"abcdefghijklmnopqrstuvwxyz", 18, "abcd" + truncMarker},
+		{"яяяяяяяяяяяяяяяяяяя", 19, "яя" + truncMarker},
+		{"abcdefghijklmnopqrstuvwxyz", 5, truncMarker},
+	} {
+		if got := truncateBytes(c.in, c.max); got != c.want {
+			t.Errorf("truncateBytes(%q, %d) = %q, ожидалось %q", c.in, c.max, got, c.want)
+		}
+	}
+}
+
+func TestMaxBodyLen(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.Color = false
+	l.MaxBodyLen = 3584
+
+	l.Info(strings.Repeat("ж", 1<<20))
+
+	line := buf.String()
+	if len(line) > 4096 {
+		t.Errorf("длина строки %d превышает размер неделимой записи", len(line))
+	}
+	if !strings.HasSuffix(line, truncMarker+"\n") {
+		t.Errorf("нет метки обрезки: %q", line[len(line)-32:])
+	}
+	if !utf8.ValidString(line) {
+		t.Errorf("строка содержит некорректный UTF-8")
+	}
+}
@@ -0,0 +1,171 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Webhook отправляет важные сообщения журнала на HTTP адрес оповещений.
+//
+// Сообщения уровня не ниже заданного отправляются POST запросом с телом
+// в формате входящих вебхуков Slack: {"text": "[ERROR] текст"}. Этот же
+// формат поддерживают Mattermost, Rocket.Chat и многие другие системы.
+// Отправляются и сообщения производных логгеров (With, Named, Clone), с
+// именем того логгера, который их записал.
+//
+// Вебхук дополняет обычный вывод логгера и не заменяет его. Отправка
+// выполняется в фоновой горутине и никогда не блокирует запись в журнал:
+// сообщения сверх лимита частоты или при переполненной очереди
+// отбрасываются, а неудачные запросы не повторяются. Все такие случаи
+// учитываются в счётчиках: Webhook.Dropped() и Webhook.Failed().
+//
+// Создаётся с помощью конструктора: log.NewWebhook().
+type Webhook struct {
+	logger *Logger
+	url    string
+	level  Level
+	limit  int
+	per    time.Duration
+	client *http.Client
+	queue  chan string
+	done   chan struct{}
+	once   sync.Once
+
+	mu     sync.Mutex
+	window time.Time // Начало текущего окна ограничения частоты.
+	count  int       // Отправлено сообщений в текущем окне.
+	closed bool      // Очередь закрыта.
+
+	sent    uint64
+	dropped uint64
+	failed  uint64
+}
+
+// NewWebhook создаёт вебхук и подключает его к указанному логгеру.
+//
+// Параметры:
+//
+// - url - Адрес входящего вебхука.
+//
+// - level - Минимальный уровень отправляемых сообщений, например: WARN.
+//
+// - limit, per - Не более limit сообщений за интервал per. Если limit
+// меньше или равен нулю, частота не ограничивается.
+//
+// Для отключения вебхука и остановки фоновой горутины вызовите: Webhook.Close().
+func NewWebhook(l *Logger, url string, level Level, limit int, per time.Duration) *Webhook {
+	w := &Webhook{
+		logger: l,
+		url:    url,
+		level:  level,
+		limit:  limit,
+		per:    per,
+		client: &http.Client{Timeout: 10 * time.Second},
+		queue:  make(chan string, 64),
+		done:   make(chan struct{}),
+	}
+
+	go w.run()
+	l.addTap(w)
+
+	return w
+}
+
+// Close отключает вебхук от логгера и дожидается отправки сообщений,
+// уже поставленных в очередь. Повторный вызов ничего не делает.
+func (w *Webhook) Close() {
+	w.once.Do(func() {
+		w.logger.removeTap(w)
+		w.mu.Lock()
+		w.closed = true
+		close(w.queue)
+		w.mu.Unlock()
+		<-w.done
+	})
+}
+
+// Sent возвращает количество успешно отправленных сообщений.
+func (w *Webhook) Sent() uint64 {
+	return atomic.LoadUint64(&w.sent)
+}
+
+// Dropped возвращает количество сообщений, отброшенных из-за лимита
+// частоты или переполнения очереди.
+func (w *Webhook) Dropped() uint64 {
+	return atomic.LoadUint64(&w.dropped)
+}
+
+// Failed возвращает количество сообщений, которые не удалось отправить.
+func (w *Webhook) Failed() uint64 {
+	return atomic.LoadUint64(&w.failed)
+}
+
+// Поставить сообщение в очередь на отправку.
+// Вызывается под мьютексом записи логгера, написавшего сообщение.
+func (w *Webhook) add(e Entry) {
+	var level, msg = e.Level, e.Message
+	if level < w.level {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+
+	if w.limit > 0 {
+		now := time.Now()
+		if now.Sub(w.window) >= w.per {
+			w.window = now
+			w.count = 0
+		}
+		if w.count >= w.limit {
+			atomic.AddUint64(&w.dropped, 1)
+			return
+		}
+		w.count++
+	}
+
+	var text = levelLabel(level) + msg
+	if e.Logger.Name != "" {
+		text = levelLabel(level) + e.Logger.Name + ": " + msg
+	}
+
+	select {
+	case w.queue <- text:
+	default:
+		atomic.AddUint64(&w.dropped, 1)
+	}
+}
+
+// Отправка сообщений из очереди.
+func (w *Webhook) run() {
+	defer close(w.done)
+
+	var buf []byte
+	for text := range w.queue {
+		buf = append(buf[:0], `{"text":`...)
+		appendJSONString(&buf, text)
+		buf = append(buf, '}')
+
+		res, err := w.client.Post(w.url, "application/json", bytes.NewReader(buf))
+		if err != nil {
+			atomic.AddUint64(&w.failed, 1)
+			continue
+		}
+
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if res.StatusCode >= 300 {
+			atomic.AddUint64(&w.failed, 1)
+		} else {
+			atomic.AddUint64(&w.sent, 1)
+		}
+	}
+}
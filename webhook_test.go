@@ -0,0 +1,89 @@
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWebhook(t *testing.T) {
+	var mu sync.Mutex
+	var texts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v struct{ Text string }
+		json.NewDecoder(r.Body).Decode(&v)
+		mu.Lock()
+		texts = append(texts, v.Text)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	l := New(io.Discard, TRACE)
+	w := NewWebhook(l, srv.URL, WARN, 2, time.Hour)
+	l.Info("не отправляется")
+	l.Warn("первое")
+	l.Warn("второе")
+	l.Warn("сверх лимита")
+	w.Close()
+
+	if w.Sent() != 2 || w.Dropped() != 1 || w.Failed() != 0 {
+		t.Errorf("счётчики: sent=%d dropped=%d failed=%d", w.Sent(), w.Dropped(), w.Failed())
+	}
+	if len(texts) != 2 || texts[0] != "[WARN]  первое" {
+		t.Errorf("неверные сообщения: %q", texts)
+	}
+}
+
+func TestWebhookFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	l := New(io.Discard, TRACE)
+	w := NewWebhook(l, srv.URL, ERROR, 0, 0)
+	l.write(ERROR, "сбой")
+	w.Close()
+
+	if w.Failed() != 1 {
+		t.Errorf("неудачных отправок: %d, ожидалась 1", w.Failed())
+	}
+}
+
+func TestWebhookDerived(t *testing.T) {
+	var mu sync.Mutex
+	var texts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v struct{ Text string }
+		json.NewDecoder(r.Body).Decode(&v)
+		mu.Lock()
+		texts = append(texts, v.Text)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	l := New(io.Discard, TRACE)
+	db := l.Clone()
+	db.Name = "db"
+	w := NewWebhook(l, srv.URL, ERROR, 0, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			db.With("id", i).Error("сбой")
+		}()
+	}
+	wg.Wait()
+	w.Close()
+	db.Error("после закрытия")
+
+	if w.Sent() != 4 || len(texts) != 4 || texts[0] != "[ERROR] db: сбой" {
+		t.Errorf("отправлено %d: %q", w.Sent(), texts)
+	}
+}
@@ -0,0 +1,80 @@
+package log
+
+import "strings"
+
+// Добавить отступ indent после каждого перевода строки в конце буфера,
+// начиная с позиции start.
+func indentTail(buf *[]byte, start int, indent string) {
+	if strings.IndexByte(string((*buf)[start:]), '\n') < 0 {
+		return
+	}
+
+	var tmp = getBuffer()
+	defer putBuffer(tmp)
+
+	*tmp = append(*tmp, (*buf)[start:]...)
+	*buf = (*buf)[:start]
+	for _, c := range *tmp {
+		*buf = append(*buf, c)
+		if c == '\n' {
+			*buf = append(*buf, indent...)
+		}
+	}
+}
+
+// Перенести текст по словам.
+//
+// Каждая строка текста разбивается на строки шириной не более width
+// колонок, строки продолжения начинаются с отступа indent. Строка
+// разрывается только на существующих пробелах и табуляциях, и только если
+// следующее слово не помещается в ширину, поэтому строки, которые
+// помещаются целиком, не изменяются: отступы и выравнивание многострочного
+// JSON или дампа сохраняются. Пробелы в месте разрыва отбрасываются.
+// Существующие переводы строк сохраняются и также получают отступ. Если
+// width меньше единицы, текст возвращается без изменений.
+func wrapText(s string, width int, indent string) string {
+	if width < 1 {
+		return s
+	}
+
+	var b strings.Builder
+	for i, para := range strings.Split(s, "\n") {
+		if i > 0 {
+			b.WriteByte('\n')
+			b.WriteString(indent)
+		}
+
+		var col int
+		var word bool // В текущей строке уже есть слово.
+		for len(para) > 0 {
+			var n = strings.IndexFunc(para, func(r rune) bool { return r != ' ' && r != '\t' })
+			if n < 0 {
+				b.WriteString(para)
+				break
+			}
+			var space = para[:n]
+			para = para[n:]
+
+			n = strings.IndexAny(para, " \t")
+			if n < 0 {
+				n = len(para)
+			}
+			var w = displayWidth(para[:n])
+
+			if word && col+len(space)+w > width {
+				b.WriteByte('\n')
+				b.WriteString(indent)
+				col = 0
+			} else {
+				b.WriteString(space)
+				col += len(space)
+			}
+			b.WriteString(para[:n])
+			col += w
+			word = true
+			para = para[n:]
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,82 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	for _, c := range []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"один два три", 0, "один два три"},
+		{"один два три", 7, "один\n> два три"},
+		{"один два три", 9, "один два\n> три"},
+		{"длинноеслово x", 4, "длинноеслово\n> x"},
+		{"а б\nв г", 10, "а б\n> в г"},
+		{"\x1b[31mкрасный\x1b[0m текст", 13, "\x1b[31mкрасный\x1b[0m текст"},
+		{"один   два", 6, "один\n> два"},
+		{"a    b\tc", 40, "a    b\tc"},
+		{"a    b\n  indented {\n    \"k\": 1\n  }", 40, "a    b\n>   indented {\n>     \"k\": 1\n>   }"},
+		{"  x y", 3, "  x\n> y"},
+	} {
+		if got := wrapText(c.in, c.width, "> "); got != c.want {
+			t.Errorf("wrapText(%q, %d) = %q, ожидалось %q", c.in, c.width, got, c.want)
+		}
+	}
+}
+
+func TestWrapWidth(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, TRACE)
+	l.HeadDate = false
+	l.WrapWidth = 30
+
+	l.Info("раз два три четыре пять")
+
+	lines := bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("ожидалось 2 строки: %q", buf.String())
+	}
+	if w := displayWidth(string(lines[0])); w > 30 {
+		t.Errorf("ширина первой строки %d превышает 30: %q", w, lines[0])
+	}
+	head := displayWidth(string(lines[0])) - displayWidth("раз два три")
+	if !bytes.HasPrefix(lines[1], bytes.Repeat([]byte(" "), head)) || lines[1][head] == ' ' {
+		t.Errorf("строка продолжения не выровнена под сообщением: %q", buf.String())
+	}
+}
+
+func TestIndentMultiline(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.IndentMultiline = true
+
+	l.Info("{\n\"a\": 1\n}")
+	l.MultilinePrefix = "  | "
+	l.Head = false
+	l.SetPrefix("db")
+	l.Info("a\nb")
+
+	if want := "[INFO] : {\n         \"a\": 1\n         }\ndb a\n  | b\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
+
+func TestWrapWidthKeepsSpacing(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.Head = false
+	l.WrapWidth = 80
+
+	l.Info("a    b\tc")
+	l.Info("тело:\n  {\n    \"k\": 1\n  }")
+
+	if want := "a    b\tc\nтело:\n  {\n    \"k\": 1\n  }\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}
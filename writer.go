@@ -0,0 +1,46 @@
+package log
+
+import (
+	"io"
+	"strings"
+)
+
+// Адаптер логгера к io.Writer. См.: Logger.Writer().
+type levelAdapter struct {
+	logger *Logger
+	level  Level
+}
+
+// Writer возвращает io.Writer, каждый вызов Write которого записывается в
+// журнал отдельным сообщением уровня level.
+//
+// Один завершающий перевод строки в данных отбрасывается, чтобы не
+// получать пустые строки между сообщениями. Это позволяет подключить
+// логгер к библиотекам, принимающим io.Writer или стандартный логгер:
+//
+//	srv := &http.Server{ErrorLog: stdlog.New(l.Writer(log.ERROR), "", 0)}
+//
+// Адаптер безопасен для одновременного использования. Сообщения уровня,
+// не соответствующего уровню важности логгера, отбрасываются, а Write при
+// этом всё равно возвращает len(p).
+func (l *Logger) Writer(level Level) io.Writer {
+	return &levelAdapter{logger: l, level: level}
+}
+
+// Writer возвращает io.Writer дефолтного логгера для уровня level.
+// Подробнее смотрите: Logger.Writer().
+func Writer(level Level) io.Writer {
+	return std.Writer(level)
+}
+
+// Write записывает данные в журнал одним сообщением.
+func (w *levelAdapter) Write(p []byte) (int, error) {
+	if !w.logger.EnabledFast(w.level) {
+		return len(p), nil
+	}
+
+	if err := w.logger.output(w.level, strings.TrimSuffix(string(p), "\n")); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,24 @@
+package log
+
+import (
+	"bytes"
+	stdlog "log"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewTestLogger(&buf)
+	l.HeadDate = false
+	l.HeadTime = false
+	l.SetLevel(INFO)
+
+	std := stdlog.New(l.Writer(WARN), "", 0)
+	std.Print("из стандартного логгера")
+	l.Writer(ERROR).Write([]byte("две строки\n\n"))
+	l.Writer(DEBUG).Write([]byte("скрыто\n"))
+
+	if want := "[WARN] : из стандартного логгера\n[ERROR]: две строки\n\n"; buf.String() != want {
+		t.Errorf("неверный вывод:\n%q\nожидалось:\n%q", buf.String(), want)
+	}
+}